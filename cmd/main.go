@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"generate_api_docs_mLua/pkg/document"
 	"generate_api_docs_mLua/pkg/generator"
@@ -11,8 +12,15 @@ import (
 )
 
 func main() {
+	strict := flag.Bool("strict", false, "문서 주석 경고를 오류로 취급합니다")
+	since := flag.String("since", "", "지정한 버전(예: 1.4.0) 이후에 추가된 API만 문서로 생성합니다")
+	deprecatedReport := flag.Bool("deprecated-report", false, "사용 중단된 API 목록(deprecated.md)을 함께 생성합니다")
+	classDOT := flag.Bool("dot", false, "클래스 다이어그램을 Graphviz DOT 파일(classes.dot)로도 생성합니다")
+	flag.Parse()
+
 	rootDir := "RootDesk/MyDesk"
 	outputDir := "document/api"
+	hasErrors := false
 
 	filesToParse, err := findLuaFiles(rootDir)
 	if err != nil {
@@ -20,45 +28,99 @@ func main() {
 		return
 	}
 
-	docs := make(map[string]*document.Documentation)
-	typeLinks := make(generator.TypeLinkInfo)
+	var pages []page
+	project := generator.NewProject()
 
 	for _, file := range filesToParse {
-		doc, err := document.ParseFile(file)
-		if err != nil {
+		docs, diags, err := document.ParseFileWithOptions(file, document.Options{Strict: *strict})
+		for _, d := range diags {
+			fmt.Println(d)
+		}
+		// 파서는 오류가 있어도 복구한 문서를 돌려주므로, -strict가 아니면 그 문서로 페이지를 만듭니다.
+		// 파일을 읽지 못했으면 문서가 없습니다.
+		if err != nil && (*strict || docs == nil) {
 			fmt.Printf("파일 파싱 오류 %s: %v\n", file, err)
+			hasErrors = true
 			continue
 		}
 
 		baseName := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
-		docs[file] = doc
+		// -since로 거를 문서도 프로젝트에는 모두 등록해야 상속, 타입 링크, ---@see가 끊기지 않습니다.
+		for _, doc := range docs {
+			// 파일에 스크립트가 여러 개 있으면 페이지 이름으로 파일 이름 대신 스크립트 이름을 씁니다.
+			name := baseName
+			if len(docs) > 1 {
+				name = scriptName(doc, baseName)
+			}
+			pages = append(pages, page{file: file, name: name, doc: doc})
+			project.Add(scriptName(doc, name), doc, pagePath(doc, outputDir, name+".md"))
+		}
+	}
 
-		if doc.DocType == "Event" || doc.DocType == "Struct" {
-			outPath := getOutputPath(doc, outputDir, baseName+".md")
-			refDir := filepath.Join(outputDir, "logic")
-			relPath, _ := filepath.Rel(refDir, outPath)
-			typeLinks[baseName] = strings.ReplaceAll(relPath, "\\", "/")
+	// ---@see는 모든 문서를 읽은 뒤에야 대상을 확인할 수 있습니다.
+	// -strict이면 대상을 찾지 못한 문서는 오류가 있는 파일처럼 페이지 없이 다시 등록합니다.
+	var checked []page
+	for _, pg := range pages {
+		diags := project.CheckSeeRefs(pg.doc)
+		for _, d := range diags {
+			d.File = pg.file
+			if *strict {
+				d.Severity = document.SeverityError
+			}
+			fmt.Println(d)
+		}
+		if *strict && len(diags) > 0 {
+			hasErrors = true
+			project.Add(scriptName(pg.doc, pg.name), pg.doc, "")
+			continue
 		}
+		checked = append(checked, pg)
 	}
+	pages = checked
 
-	for file, doc := range docs {
-		baseName := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
-		outPath := getOutputPath(doc, outputDir, baseName+".md")
+	// -since로 걸러진 문서는 페이지 없이 다시 등록합니다. 이름은 계속 찾을 수 있지만 색인과 링크에서는 빠집니다.
+	// 일부 멤버만 남은 문서는 남은 멤버로 바꿔 등록하여 색인의 멤버 수와 페이지가 일치하게 합니다.
+	if *since != "" {
+		var written []page
+		for _, pg := range pages {
+			key := scriptName(pg.doc, pg.name)
+			filtered := document.FilterSince(pg.doc, *since)
+			if filtered == nil {
+				project.Add(key, pg.doc, "")
+				continue
+			}
+			project.Add(key, filtered, pagePath(filtered, outputDir, pg.name+".md"))
+			pg.doc = filtered
+			written = append(written, pg)
+		}
+		pages = written
+	}
+
+	for _, pg := range pages {
+		doc := pg.doc
+		outPath := getOutputPath(doc, outputDir, pg.name+".md")
 
 		// 원본 mlua 파일에 대한 상대 경로 계산
-		relPathToSource, err := filepath.Rel(filepath.Dir(outPath), file)
+		relPathToSource, err := filepath.Rel(filepath.Dir(outPath), pg.file)
 		if err != nil {
 			fmt.Printf("상대 경로 계산 오류: %v\n", err)
 			// 실패 시 대체 경로 사용 (루트 기준)
-			relPathToSource = file
+			relPathToSource = pg.file
 		}
 		// URL 경로 형식으로 변경
 		relPathToSource = strings.ReplaceAll(relPathToSource, "\\", "/")
 
-		// Generate 함수에 문서 제목과 원본 파일 링크를 전달
-		mdContent, err := generator.Generate(doc, baseName, relPathToSource, typeLinks)
+		// Generate 함수에 문서 제목과 원본 파일 링크, 이 페이지 기준의 타입 링크를 전달
+		path := pagePath(doc, outputDir, pg.name+".md")
+		mdContent, err := generator.Generate(doc, generator.Page{
+			Title:      pg.name,
+			SourceLink: relPathToSource,
+			Path:       path,
+			TypeLinks:  project.TypeLinks(path),
+			Project:    project,
+		})
 		if err != nil {
-			fmt.Printf("문서 생성 오류 %s: %v\n", file, err)
+			fmt.Printf("문서 생성 오류 %s: %v\n", pg.file, err)
 			continue
 		}
 
@@ -73,9 +135,56 @@ func main() {
 		fmt.Printf("문서 생성 완료: %s\n", outPath)
 	}
 
+	// 프로젝트 전체와 DocType 폴더마다 클래스 다이어그램이 들어간 색인 페이지를 만듭니다.
+	for _, folder := range append([]string{"."}, project.Folders()...) {
+		indexPath := filepath.Join(outputDir, filepath.FromSlash(generator.IndexPath(folder)))
+		writeOutput(indexPath, generator.GenerateIndex(project, folder), "색인 페이지")
+		if *classDOT {
+			dotPath := filepath.Join(filepath.Dir(indexPath), "classes.dot")
+			writeOutput(dotPath, generator.GenerateClassDiagramDOT(project.ScriptsIn(folder), project), "클래스 다이어그램")
+		}
+	}
+
+	// 이벤트 흐름 그래프는 Markdown 문서와 같은 폴더에 Mermaid, DOT 두 형식으로 만듭니다.
+	writeOutput(filepath.Join(outputDir, "event-flow.md"), generator.GenerateEventFlowMermaid(project, "event-flow.md"), "이벤트 흐름 그래프")
+	writeOutput(filepath.Join(outputDir, "event-flow.dot"), generator.GenerateEventFlowDOT(project, "event-flow.dot"), "이벤트 흐름 그래프")
+
+	if *deprecatedReport {
+		reportPath := filepath.Join(outputDir, "deprecated.md")
+		report := generator.GenerateDeprecatedReport(project, "deprecated.md")
+		if err := os.WriteFile(reportPath, []byte(report), 0644); err != nil {
+			fmt.Printf("파일 쓰기 오류 %s: %v\n", reportPath, err)
+		} else {
+			fmt.Printf("사용 중단 API 목록 생성 완료: %s\n", reportPath)
+		}
+	}
+
+	if hasErrors {
+		fmt.Println("오류가 있는 파일을 제외하고 문서 생성이 완료되었습니다.")
+		os.Exit(1)
+	}
 	fmt.Println("모든 문서 생성이 완료되었습니다.")
 }
 
+// page는 문서 페이지 하나가 될 스크립트입니다. name은 확장자를 뺀 페이지 파일 이름입니다.
+type page struct {
+	file, name string
+	doc        *document.Documentation
+}
+
+// writeOutput은 content를 path에 쓰고 결과를 출력합니다. what은 완료 메시지에 쓸 파일 설명입니다.
+func writeOutput(path, content, what string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		fmt.Printf("디렉토리 생성 오류 %s: %v\n", filepath.Dir(path), err)
+		return
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		fmt.Printf("파일 쓰기 오류 %s: %v\n", path, err)
+		return
+	}
+	fmt.Printf("%s 생성 완료: %s\n", what, path)
+}
+
 func findLuaFiles(root string) ([]string, error) {
 	var files []string
 	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
@@ -90,6 +199,20 @@ func findLuaFiles(root string) ([]string, error) {
 	return files, err
 }
 
+// scriptName은 script 헤더의 이름을 반환하고, 헤더가 없으면 파일 이름으로 대신합니다.
+func scriptName(doc *document.Documentation, baseName string) string {
+	if doc.Name != "" {
+		return doc.Name
+	}
+	return baseName
+}
+
+// pagePath는 출력 루트 기준의 문서 경로를 슬래시 구분으로 반환합니다.
+func pagePath(doc *document.Documentation, baseDir, fileName string) string {
+	relPath, _ := filepath.Rel(baseDir, getOutputPath(doc, baseDir, fileName))
+	return filepath.ToSlash(relPath)
+}
+
 func getOutputPath(doc *document.Documentation, baseDir, fileName string) string {
 	docTypeDir := "etc"
 	if doc.DocType != "" {
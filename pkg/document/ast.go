@@ -0,0 +1,105 @@
+package document
+
+import "strings"
+
+// 이 파일은 mLua 선언 문법의 AST를 정의합니다. 파서는 문서화에 필요한 선언
+// (script, property, method, handler)만 노드로 만들고 나머지 코드는 건너뜁니다.
+
+// fileNode는 하나의 .mlua 소스 전체입니다.
+type fileNode struct {
+	scripts []*scriptNode
+}
+
+// docNode는 선언 앞에 붙은 `---` 문서 주석 줄의 묶음입니다.
+type docNode struct {
+	lines      []docLine
+	start, end Pos
+}
+
+type docLine struct {
+	text string // `---` 뒤의 원문
+	pos  Pos
+}
+
+func (d *docNode) span() Span {
+	if d == nil {
+		return Span{}
+	}
+	return Span{Start: d.start, End: d.end}
+}
+
+// attributeNode는 `@Name` 또는 `@Name("a", "b")` 형태의 어트리뷰트입니다.
+type attributeNode struct {
+	name string
+	args []string
+	pos  Pos
+}
+
+// scriptNode는 `script Name extends Base ... end` 블록입니다.
+// 헤더 없이 `@Logic` 같은 어트리뷰트만 있는 소스는 hasHeader가 false인 노드가 됩니다.
+type scriptNode struct {
+	doc        *docNode
+	attributes []attributeNode
+	kind       string // @Logic, @Component 등에서 얻은 스크립트 종류
+	name       string
+	extends    string
+	hasHeader  bool
+	members    []memberNode
+	start, end Pos
+}
+
+func (s *scriptNode) span() Span { return Span{Start: s.start, End: s.end} }
+
+// memberNode는 스크립트 본문의 선언(propertyNode, methodNode, handlerNode)입니다.
+type memberNode interface {
+	decl() *declNode
+}
+
+// declNode는 모든 멤버 선언이 공유하는 부분입니다.
+type declNode struct {
+	doc        *docNode
+	attributes []attributeNode
+	modifiers  []string // static, readonly, local 등
+	start, end Pos
+}
+
+func (d *declNode) decl() *declNode { return d }
+
+func (d *declNode) span() Span { return Span{Start: d.start, End: d.end} }
+
+func (d *declNode) hasModifier(name string) bool {
+	for _, m := range d.modifiers {
+		if m == name {
+			return true
+		}
+	}
+	return false
+}
+
+type propertyNode struct {
+	declNode
+	typ, name, value string
+}
+
+type methodNode struct {
+	declNode
+	returnType, name string
+	params           []paramNode
+}
+
+type handlerNode struct {
+	declNode
+	returnType, name string // returnType은 생략될 수 있습니다.
+	params           []paramNode
+}
+
+// paramNode는 시그니처의 파라미터 하나입니다. `number speed = 1.0`의 기본값은 value에 담깁니다.
+type paramNode struct {
+	typ, name, value string
+	pos              Pos
+}
+
+// optional은 기본값이 있거나 nullable 타입(`any?`)인 파라미터인지 확인합니다.
+func (n paramNode) optional() bool {
+	return n.value != "" || strings.HasSuffix(n.typ, "?")
+}
@@ -0,0 +1,358 @@
+package document
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 이 파일은 `---` 문서 주석의 태그(@description, @param 등)를 해석합니다.
+
+// reParam은 `@param` 태그의 이름과 나머지(타입과 설명)를 나눕니다.
+var reParam = regexp.MustCompile(`^([a-zA-Z_][a-zA-Z0-9_]*\??)\s+(\S.*)$`)
+
+// docTag는 문서 주석의 태그 하나입니다. `---@name text` 줄과, 다음 태그 전까지 이어지는 `---` 줄로 이루어집니다.
+type docTag struct {
+	name string
+	text string   // 태그 이름 뒤 첫 줄의 내용
+	more []string // 이어지는 줄들
+	pos  Pos
+}
+
+// body는 태그의 첫 줄과 이어지는 줄을 줄바꿈으로 합친 내용입니다.
+func (t docTag) body() string {
+	lines := append([]string{t.text}, t.more...)
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// splitTags는 문서 주석을 태그 단위로 나눕니다. 첫 태그 앞에 오는 줄은 untagged로 반환합니다.
+func splitTags(doc *docNode) (untagged []string, tags []docTag) {
+	if doc == nil {
+		return nil, nil
+	}
+	for _, l := range doc.lines {
+		line := docLineText(l.text)
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "@") && len(trimmed) > 1 && isIdentStart(trimmed[1]) {
+			name := trimmed[1:]
+			text := ""
+			if i := strings.IndexFunc(name, func(r rune) bool { return r > 127 || !isIdentPart(byte(r)) }); i >= 0 {
+				name, text = name[:i], strings.TrimSpace(name[i:])
+			}
+			tags = append(tags, docTag{name: name, text: text, pos: l.pos})
+			continue
+		}
+		if len(tags) == 0 {
+			untagged = append(untagged, line)
+			continue
+		}
+		last := &tags[len(tags)-1]
+		last.more = append(last.more, line)
+	}
+	return untagged, tags
+}
+
+// docLineText는 `---` 뒤의 원문에서 관례적인 공백 한 칸과 줄 끝 공백을 떼어냅니다.
+// 그 이상의 들여쓰기는 목록이나 코드 블록을 위해 남겨 둡니다.
+func docLineText(raw string) string {
+	raw = strings.TrimRight(raw, " \t\r")
+	return strings.TrimPrefix(raw, " ")
+}
+
+// unquoteDoc은 `"`로 시작하는 태그 내용에서 닫는 따옴표까지를 읽습니다.
+// `\"`와 `\\`만 이스케이프로 해석하고, 그 밖의 역슬래시는 Markdown을 위해 그대로 둡니다.
+func unquoteDoc(s string) (value string, closed bool) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) && (s[i+1] == '"' || s[i+1] == '\\') {
+			i++
+			b.WriteByte(s[i])
+			continue
+		}
+		if c == '"' {
+			return b.String(), true
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), false
+}
+
+// commentAttributes는 문서 주석의 태그에서 읽어낸 값입니다.
+type commentAttributes struct {
+	desc          string
+	params        []paramTag
+	returns       *returnTag
+	deprecated    bool
+	deprecatedMsg string
+	examples      []Example
+	see           []SeeRef
+	since         string
+	version       string
+	versionPos    Pos
+	metadata      map[string]string
+}
+
+// returnTag는 `---@return 타입 "설명"` 태그입니다.
+type returnTag struct {
+	typ, desc string
+	pos       Pos
+}
+
+// paramTag는 `---@param` 태그 하나와 그 위치입니다.
+type paramTag struct {
+	ParamInfo
+	pos Pos
+}
+
+func (c commentAttributes) paramInfos() []ParamInfo {
+	var params []ParamInfo
+	for _, p := range c.params {
+		params = append(params, p.ParamInfo)
+	}
+	return params
+}
+
+func (c commentAttributes) returnDescription() string {
+	if c.returns == nil {
+		return ""
+	}
+	return c.returns.desc
+}
+
+// parseCommonAttributes는 문서 주석의 태그 값을 모읍니다. 형식이 잘못된 태그는 diags에 경고로 남깁니다.
+// @description이 없으면 첫 태그 앞의 자유 형식 줄을 설명으로 사용합니다.
+func parseCommonAttributes(doc *docNode, diags *Diagnostics) (attrs commentAttributes) {
+	untagged, tags := splitTags(doc)
+	for _, tag := range tags {
+		switch tag.name {
+		case "description":
+			desc, ok := parseDocText(tag, diags)
+			if ok && attrs.desc == "" {
+				attrs.desc = desc
+			}
+		case "param":
+			match := reParam.FindStringSubmatch(tag.text)
+			var typ, rest string
+			if len(match) == 3 {
+				typ, rest = cutTypeExpr(match[2])
+			}
+			if _, err := ParseTypeExpr(typ); len(match) < 3 || err != nil {
+				diags.add(SeverityWarning, CodeMalformedParam, tag.pos, "@param은 `@param 이름 타입 설명` 형식이어야 합니다")
+				continue
+			}
+			// `이름?`이나 `= 기본값`으로 선택 파라미터를 표시할 수 있습니다.
+			name, optional := strings.CutSuffix(match[1], "?")
+			def := ""
+			if after, ok := strings.CutPrefix(rest, "="); ok {
+				def, rest = cutDefault(strings.TrimSpace(after))
+				optional = true
+			}
+			desc := strings.TrimSpace(strings.Join(append([]string{rest}, tag.more...), "\n"))
+			if strings.HasPrefix(desc, `"`) {
+				desc, _ = unquoteDoc(desc)
+			}
+			attrs.params = append(attrs.params, paramTag{
+				ParamInfo: ParamInfo{
+					Type:        typ,
+					Name:        name,
+					Description: desc,
+					Default:     def,
+					Optional:    optional || strings.HasSuffix(typ, "?"),
+				},
+				pos: tag.pos,
+			})
+		case "return":
+			if ret, ok := parseReturnTag(tag, diags); ok && attrs.returns == nil {
+				attrs.returns = ret
+			}
+		case "example":
+			if ex, ok := parseExampleTag(tag, diags); ok {
+				attrs.examples = append(attrs.examples, ex)
+			}
+		case "see":
+			fields := strings.Fields(tag.text)
+			if len(fields) == 0 {
+				diags.add(SeverityWarning, CodeMalformedSee, tag.pos, "@see 뒤에 참조할 스크립트나 멤버가 없습니다")
+				continue
+			}
+			attrs.see = append(attrs.see, SeeRef{Target: fields[0], Pos: tag.pos})
+		case "since":
+			attrs.since, _ = parseDocText(tag, diags)
+		case "version":
+			attrs.version, _ = parseDocText(tag, diags)
+			attrs.versionPos = tag.pos
+		case "deprecated":
+			// 안내 문구 없이 `---@deprecated`만 써도 됩니다.
+			attrs.deprecated = true
+			if tag.body() != "" {
+				attrs.deprecatedMsg, _ = parseDocText(tag, diags)
+			}
+		default:
+			// 알 수 없는 태그는 버리지 않고 메타데이터로 남깁니다. `---@perf`처럼 내용이 없어도 됩니다.
+			value := ""
+			if tag.body() != "" {
+				value, _ = parseDocText(tag, diags)
+			}
+			if attrs.metadata == nil {
+				attrs.metadata = make(map[string]string)
+			}
+			if prev, ok := attrs.metadata[tag.name]; ok && prev != "" {
+				value = prev + "\n" + value
+			}
+			attrs.metadata[tag.name] = value
+		}
+	}
+	if attrs.desc == "" {
+		attrs.desc = strings.TrimSpace(strings.Join(untagged, "\n"))
+	}
+	return
+}
+
+// parseReturnTag는 `@return 타입 설명`에서 타입과 설명을 나눕니다. 설명은 @description과 같은 형식입니다.
+func parseReturnTag(tag docTag, diags *Diagnostics) (*returnTag, bool) {
+	typ, rest := cutTypeExpr(tag.text)
+	if _, err := ParseTypeExpr(typ); err != nil {
+		diags.add(SeverityWarning, CodeMalformedReturn, tag.pos, "@return은 `@return 타입 \"설명\"` 형식이어야 합니다")
+		return nil, false
+	}
+	ret := &returnTag{typ: typ, pos: tag.pos}
+	if body := (docTag{name: tag.name, text: strings.TrimSpace(rest), more: tag.more, pos: tag.pos}); body.body() != "" {
+		ret.desc, _ = parseDocText(body, diags)
+	}
+	return ret, true
+}
+
+// parseExampleTag는 `---@example [제목]` 뒤에 이어지는 줄을 예제 코드로 읽습니다.
+// 이어지는 줄 없이 한 줄만 있으면 그 내용을 코드로 봅니다. 작성자가 넣은 ``` 펜스는 벗겨냅니다.
+func parseExampleTag(tag docTag, diags *Diagnostics) (Example, bool) {
+	lines := tag.more
+	title := tag.text
+	if len(lines) == 0 {
+		lines, title = []string{tag.text}, ""
+	}
+	if len(lines) > 0 && strings.HasPrefix(strings.TrimSpace(lines[0]), "```") {
+		lines = lines[1:]
+		if n := len(lines); n > 0 && strings.TrimSpace(lines[n-1]) == "```" {
+			lines = lines[:n-1]
+		}
+	}
+	code := strings.Trim(dedent(lines), "\n")
+	if code == "" {
+		diags.add(SeverityWarning, CodeMalformedExample, tag.pos, "@example에 코드가 없습니다")
+		return Example{}, false
+	}
+	return Example{Title: title, Code: code}, true
+}
+
+// dedent는 모든 줄에 공통인 앞쪽 공백을 제거하고 줄을 합칩니다. 빈 줄은 계산에서 제외합니다.
+func dedent(lines []string) string {
+	indent := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		n := len(l) - len(strings.TrimLeft(l, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		if len(l) >= indent && indent > 0 {
+			l = l[indent:]
+		}
+		out[i] = strings.TrimRight(l, " \t")
+	}
+	return strings.Join(out, "\n")
+}
+
+// checkReturnTag는 @return을 선언의 반환 타입과 비교합니다. 반환 타입이 없는 선언의 @return은 잘못 놓인 태그입니다.
+func checkReturnTag(ret *returnTag, returnType, owner string, diags *Diagnostics) {
+	if ret == nil {
+		return
+	}
+	switch {
+	case returnType == "" || returnType == "void":
+		diags.add(SeverityWarning, CodeMisplacedTag, ret.pos, "반환 값이 없는 %s에 @return이 있습니다", owner)
+	case !sameType(ret.typ, returnType):
+		diags.add(SeverityWarning, CodeReturnMismatch, ret.pos, "@return 타입 %s가 %s의 반환 타입 %s와 다릅니다", ret.typ, owner, returnType)
+	}
+}
+
+// sameType은 두 타입 표현식이 공백 차이를 빼고 같은지 확인합니다.
+func sameType(a, b string) bool {
+	x, errA := ParseTypeExpr(a)
+	y, errB := ParseTypeExpr(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return x.String() == y.String()
+}
+
+// parseDocText는 `"..."` 또는 따옴표 없는 여러 줄 텍스트 형식의 태그 내용을 읽습니다.
+func parseDocText(tag docTag, diags *Diagnostics) (string, bool) {
+	body := tag.body()
+	if body == "" {
+		diags.add(SeverityWarning, CodeMalformedDescription, tag.pos, "@%s의 내용이 비어 있습니다", tag.name)
+		return "", false
+	}
+	if !strings.HasPrefix(body, `"`) {
+		return body, true
+	}
+	value, closed := unquoteDoc(body)
+	if !closed {
+		diags.add(SeverityWarning, CodeUnterminatedDescription, tag.pos, "@%s의 따옴표가 닫히지 않았습니다", tag.name)
+	}
+	return value, true
+}
+
+// checkVersionTag는 스크립트가 아닌 선언에 붙은 @version을 잘못 놓인 태그로 남깁니다.
+func checkVersionTag(attrs commentAttributes, owner string, diags *Diagnostics) {
+	if attrs.version != "" {
+		diags.add(SeverityWarning, CodeMisplacedTag, attrs.versionPos, "@version은 스크립트에만 쓸 수 있습니다 (%s)", owner)
+	}
+}
+
+// cutDefault는 `@param` 태그의 `= 값` 뒤에서 기본값 하나를 잘라 냅니다. 따옴표로 감싼 값은 공백을 포함할 수 있습니다.
+func cutDefault(s string) (def, rest string) {
+	end := strings.IndexAny(s, " \t")
+	if s != "" && (s[0] == '"' || s[0] == '\'') {
+		end = -1
+		for i := 1; i < len(s); i++ {
+			if s[i] == '\\' {
+				i++
+				continue
+			}
+			if s[i] == s[0] {
+				end = i + 1
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], strings.TrimSpace(s[end:])
+}
+
+// checkParamTags는 시그니처에 없는 파라미터를 설명하는 `---@param`을 경고로 남깁니다.
+// 태그의 기본값이나 선택 여부가 시그니처와 맞지 않는 경우도 경고합니다.
+func checkParamTags(tags []paramTag, signature []paramNode, owner string, diags *Diagnostics) {
+	params := make(map[string]paramNode)
+	for _, p := range signature {
+		params[p.name] = p
+	}
+	for _, tag := range tags {
+		p, ok := params[tag.Name]
+		switch {
+		case !ok:
+			diags.add(SeverityWarning, CodeOrphanParam, tag.pos, "%s에 %s 파라미터가 없습니다", owner, tag.Name)
+		case tag.Default != "" && p.value == "":
+			diags.add(SeverityWarning, CodeParamDefaultMismatch, tag.pos, "@param %s의 기본값 %s가 %s 시그니처에 없습니다", tag.Name, tag.Default, owner)
+		case tag.Default != "" && tag.Default != p.value:
+			diags.add(SeverityWarning, CodeParamDefaultMismatch, tag.pos, "@param %s의 기본값 %s가 %s 시그니처의 기본값 %s와 다릅니다", tag.Name, tag.Default, owner, p.value)
+		case tag.Optional && !p.optional():
+			diags.add(SeverityWarning, CodeParamDefaultMismatch, tag.pos, "@param %s는 선택 파라미터로 표시되었지만 %s 시그니처에서는 필수입니다", tag.Name, owner)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package document
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Severity는 진단의 심각도입니다.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// 진단 코드 목록
+const (
+	CodeUnterminatedString      = "unterminated-string"
+	CodeUnterminatedComment     = "unterminated-comment"
+	CodeInvalidDeclaration      = "invalid-declaration"
+	CodeMissingEnd              = "missing-end"
+	CodeOrphanDoc               = "orphan-doc"
+	CodeOrphanParam             = "orphan-param"
+	CodeMalformedParam          = "malformed-param"
+	CodeMisplacedAttribute      = "misplaced-attribute"
+	CodeMalformedDescription    = "malformed-description"
+	CodeUnterminatedDescription = "unterminated-description"
+	CodeMalformedReturn         = "malformed-return"
+	CodeReturnMismatch          = "return-mismatch"
+	CodeMisplacedTag            = "misplaced-tag"
+	CodeMalformedExample        = "malformed-example"
+	CodeMalformedSee            = "malformed-see"
+	CodeUnresolvedSee           = "unresolved-see" // 생성기가 프로젝트 전체 문서를 보고 남깁니다.
+	CodeParamDefaultMismatch    = "param-default-mismatch"
+)
+
+// Diagnostic은 파싱 중 발견한 문제 하나입니다.
+type Diagnostic struct {
+	Severity Severity
+	Code     string
+	Message  string
+	File     string // ParseFile 계열에서만 채워집니다.
+	Pos      Pos
+}
+
+// String은 `file:line:col: severity: message [code]` 형식의 문자열을 반환합니다.
+func (d Diagnostic) String() string {
+	loc := fmt.Sprintf("%d:%d", d.Pos.Line, d.Pos.Column)
+	if d.File != "" {
+		loc = d.File + ":" + loc
+	}
+	return fmt.Sprintf("%s: %s: %s [%s]", loc, d.Severity, d.Message, d.Code)
+}
+
+// Diagnostics는 한 번의 파싱에서 나온 진단 목록입니다.
+type Diagnostics []Diagnostic
+
+func (ds *Diagnostics) add(severity Severity, code string, pos Pos, format string, args ...any) {
+	*ds = append(*ds, Diagnostic{
+		Severity: severity,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Pos:      pos,
+	})
+}
+
+// HasErrors는 오류 수준의 진단이 하나라도 있는지 확인합니다.
+func (ds Diagnostics) HasErrors() bool {
+	for _, d := range ds {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err는 오류 수준의 진단을 하나의 error로 묶어 반환합니다. 오류가 없으면 nil입니다.
+func (ds Diagnostics) Err() error {
+	var errs []error
+	for _, d := range ds {
+		if d.Severity == SeverityError {
+			errs = append(errs, errors.New(d.String()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sort는 진단을 소스 위치 순으로 정렬합니다.
+func (ds Diagnostics) sort() {
+	sort.SliceStable(ds, func(i, j int) bool {
+		if ds[i].Pos.Line != ds[j].Pos.Line {
+			return ds[i].Pos.Line < ds[j].Pos.Line
+		}
+		return ds[i].Pos.Column < ds[j].Pos.Column
+	})
+}
+
+// Options는 파싱 동작을 설정합니다.
+type Options struct {
+	// Strict가 true이면 경고를 오류로 취급합니다.
+	Strict bool
+}
+
+// apply는 옵션에 따라 진단의 심각도를 조정합니다.
+func (o Options) apply(ds Diagnostics) {
+	if !o.Strict {
+		return
+	}
+	for i := range ds {
+		if ds[i].Severity == SeverityWarning {
+			ds[i].Severity = SeverityError
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package document
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// tokenKind는 mLua 토큰의 종류입니다.
+type tokenKind int
+
+const (
+	tokEOF    tokenKind = iota
+	tokIdent            // 식별자와 키워드
+	tokNumber           // 숫자 리터럴
+	tokString           // 문자열 리터럴 ("...", '...', [[...]])
+	tokDoc              // `---`로 시작하는 문서 주석 한 줄
+	tokPunct            // 연산자와 구두점
+)
+
+// token은 렉서가 만드는 최소 단위입니다. start/end는 원본 소스의 바이트 오프셋입니다.
+type token struct {
+	kind       tokenKind
+	text       string
+	pos        Pos  // 첫 문자의 위치
+	endPos     Pos  // 마지막 문자의 위치
+	start, end int  // 원본 소스에서의 바이트 범위 [start, end)
+	first      bool // 해당 줄의 첫 토큰인지 여부
+}
+
+// lexer는 mLua 소스를 토큰으로 나눕니다. 일반 주석(`--`, `--[[ ]]`)은 버리고
+// 문서 주석(`---`)만 tokDoc 토큰으로 남깁니다.
+type lexer struct {
+	src       string
+	off       int
+	line, col int
+	lineStart bool
+	toks      []token
+	diags     *Diagnostics
+}
+
+func tokenize(src string, diags *Diagnostics) []token {
+	lx := &lexer{src: src, line: 1, col: 1, lineStart: true, diags: diags}
+	lx.run()
+	return lx.toks
+}
+
+func (lx *lexer) run() {
+	for lx.off < len(lx.src) {
+		c := lx.src[lx.off]
+		rest := lx.src[lx.off:]
+		switch {
+		case c == '\n' || c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			lx.advance()
+		case strings.HasPrefix(rest, "--"):
+			lx.lexComment()
+		case isIdentStart(c):
+			lx.lexIdent()
+		case isDigit(c) || (c == '.' && len(rest) > 1 && isDigit(rest[1])):
+			lx.lexNumber()
+		case c == '"' || c == '\'':
+			lx.lexQuotedString(c)
+		case c == '[' && longBracketLevel(rest) >= 0:
+			lx.lexLongString()
+		default:
+			lx.lexPunct()
+		}
+	}
+	lx.toks = append(lx.toks, token{
+		kind:   tokEOF,
+		pos:    Pos{lx.line, lx.col},
+		endPos: Pos{lx.line, lx.col},
+		start:  lx.off,
+		end:    lx.off,
+		first:  lx.lineStart,
+	})
+}
+
+// advance는 한 문자(rune)를 소비하고 줄/열 정보를 갱신합니다.
+func (lx *lexer) advance() {
+	r, size := utf8.DecodeRuneInString(lx.src[lx.off:])
+	lx.off += size
+	if r == '\n' {
+		lx.line++
+		lx.col = 1
+		lx.lineStart = true
+	} else {
+		lx.col++
+	}
+}
+
+func (lx *lexer) advanceN(n int) {
+	for end := lx.off + n; lx.off < end && lx.off < len(lx.src); {
+		lx.advance()
+	}
+}
+
+func (lx *lexer) skipToLineEnd() {
+	for lx.off < len(lx.src) && lx.src[lx.off] != '\n' {
+		lx.advance()
+	}
+}
+
+// emit은 start 오프셋부터 현재 위치까지를 하나의 토큰으로 기록합니다.
+func (lx *lexer) emit(kind tokenKind, start int, pos Pos, first bool) {
+	lx.emitText(kind, lx.src[start:lx.off], start, pos, first)
+}
+
+func (lx *lexer) emitText(kind tokenKind, text string, start int, pos Pos, first bool) {
+	endPos := Pos{lx.line, lx.col - 1}
+	if endPos.Column < 1 {
+		endPos = pos
+	}
+	lx.toks = append(lx.toks, token{
+		kind:   kind,
+		text:   text,
+		pos:    pos,
+		endPos: endPos,
+		start:  start,
+		end:    lx.off,
+		first:  first,
+	})
+	lx.lineStart = false
+}
+
+func (lx *lexer) lexComment() {
+	start, pos, first := lx.off, Pos{lx.line, lx.col}, lx.lineStart
+	lx.advanceN(2)
+	rest := lx.src[lx.off:]
+
+	// 블록 주석 --[[ ... ]]
+	if level := longBracketLevel(rest); level >= 0 {
+		if !lx.skipLongBracket(level) {
+			lx.diags.add(SeverityError, CodeUnterminatedComment, pos, "블록 주석이 닫히지 않았습니다")
+		}
+		return
+	}
+
+	// `---` 문서 주석. `----`처럼 구분선으로 쓰인 줄은 일반 주석으로 취급합니다.
+	if strings.HasPrefix(rest, "-") && !strings.HasPrefix(rest, "--") {
+		lx.advance()
+		textStart := lx.off
+		lx.skipToLineEnd()
+		text := strings.TrimRight(lx.src[textStart:lx.off], "\r")
+		lx.emitText(tokDoc, text, start, pos, first)
+		return
+	}
+
+	lx.skipToLineEnd()
+}
+
+func (lx *lexer) lexIdent() {
+	start, pos, first := lx.off, Pos{lx.line, lx.col}, lx.lineStart
+	for lx.off < len(lx.src) && isIdentPart(lx.src[lx.off]) {
+		lx.advance()
+	}
+	lx.emit(tokIdent, start, pos, first)
+}
+
+func (lx *lexer) lexNumber() {
+	start, pos, first := lx.off, Pos{lx.line, lx.col}, lx.lineStart
+	for lx.off < len(lx.src) {
+		c := lx.src[lx.off]
+		if isIdentPart(c) || c == '.' {
+			lx.advance()
+			// 지수부의 부호 (1e-3, 0x1p+4)
+			if (c == 'e' || c == 'E' || c == 'p' || c == 'P') && lx.off < len(lx.src) &&
+				(lx.src[lx.off] == '+' || lx.src[lx.off] == '-') {
+				lx.advance()
+			}
+			continue
+		}
+		break
+	}
+	lx.emit(tokNumber, start, pos, first)
+}
+
+func (lx *lexer) lexQuotedString(quote byte) {
+	start, pos, first := lx.off, Pos{lx.line, lx.col}, lx.lineStart
+	lx.advance()
+	closed := false
+	for lx.off < len(lx.src) && !closed {
+		c := lx.src[lx.off]
+		if c == '\n' {
+			break
+		}
+		lx.advance()
+		if c == '\\' && lx.off < len(lx.src) {
+			lx.advance()
+			continue
+		}
+		closed = c == quote
+	}
+	if !closed {
+		lx.diags.add(SeverityError, CodeUnterminatedString, pos, "문자열이 닫히지 않았습니다")
+	}
+	lx.emit(tokString, start, pos, first)
+}
+
+func (lx *lexer) lexLongString() {
+	start, pos, first := lx.off, Pos{lx.line, lx.col}, lx.lineStart
+	if !lx.skipLongBracket(longBracketLevel(lx.src[lx.off:])) {
+		lx.diags.add(SeverityError, CodeUnterminatedString, pos, "긴 문자열이 닫히지 않았습니다")
+	}
+	lx.emit(tokString, start, pos, first)
+}
+
+// skipLongBracket은 `[==[`부터 같은 레벨의 `]==]`까지 건너뜁니다. 닫히지 않았다면 false를 반환합니다.
+func (lx *lexer) skipLongBracket(level int) bool {
+	lx.advanceN(level + 2)
+	closing := "]" + strings.Repeat("=", level) + "]"
+	if idx := strings.Index(lx.src[lx.off:], closing); idx >= 0 {
+		lx.advanceN(idx + len(closing))
+		return true
+	}
+	lx.advanceN(len(lx.src) - lx.off)
+	return false
+}
+
+var multiCharPuncts = []string{"...", "..", "==", "~=", "<=", ">=", "::", "//"}
+
+func (lx *lexer) lexPunct() {
+	start, pos, first := lx.off, Pos{lx.line, lx.col}, lx.lineStart
+	rest := lx.src[lx.off:]
+	for _, p := range multiCharPuncts {
+		if strings.HasPrefix(rest, p) {
+			lx.advanceN(len(p))
+			lx.emit(tokPunct, start, pos, first)
+			return
+		}
+	}
+	// `<`와 `>`는 제네릭 타입(table<string, table<string, number>>)을 위해 항상 한 글자씩 자릅니다.
+	lx.advance()
+	lx.emit(tokPunct, start, pos, first)
+}
+
+// longBracketLevel은 s가 `[[` 또는 `[=*[`로 시작하면 `=`의 개수를, 아니면 -1을 반환합니다.
+func longBracketLevel(s string) int {
+	if !strings.HasPrefix(s, "[") {
+		return -1
+	}
+	i := 1
+	for i < len(s) && s[i] == '=' {
+		i++
+	}
+	if i < len(s) && s[i] == '[' {
+		return i - 1
+	}
+	return -1
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
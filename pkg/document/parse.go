@@ -2,64 +2,108 @@ package document
 
 import (
 	"os"
-	"regexp"
+	"strconv"
 	"strings"
 )
 
-var (
-	reDocType = regexp.MustCompile(`^@(Logic|Component|Event|Struct|BTNode|Item|State)\b`)
+// scriptKinds는 스크립트 종류를 나타내는 어트리뷰트 이름입니다.
+var scriptKinds = map[string]bool{
+	"Logic":     true,
+	"Component": true,
+	"Event":     true,
+	"Struct":    true,
+	"BTNode":    true,
+	"Item":      true,
+	"State":     true,
+	"Enum":      true,
+}
 
-	reDesc        = regexp.MustCompile(`---@description\s*"([^"]+)"`)
-	reExecSpace   = regexp.MustCompile(`@ExecSpace\("([^"]+)"\)`)
-	reEventSender = regexp.MustCompile(`@EventSender\("([^"]+)"(?:,\s*"([^"]+)")?\)`)
-	reParam       = regexp.MustCompile(`---@param\s+([a-zA-Z_<>|]+)\s+([a-zA-Z0-9_]+)\s*(.*)`)
+// lifecycleMethods는 엔진이 호출하는 생명주기 콜백입니다. 같은 이름의 메서드는 재정의로 봅니다.
+var lifecycleMethods = map[string]bool{
+	"OnInitialize":   true,
+	"OnBeginPlay":    true,
+	"OnUpdate":       true,
+	"OnEndPlay":      true,
+	"OnDestroy":      true,
+	"OnSyncProperty": true,
+	"OnMapEnter":     true,
+	"OnMapLeave":     true,
+}
 
-	// `readonly` 키워드를 선택적으로 포함하도록 수정
-	rePropertyCore = regexp.MustCompile(`(?:readonly\s+)?property\s+([a-zA-Z_<>]+)\s+([a-zA-Z0-9_]+)\s*=\s*"?([^"]+)"?`)
-	reMethodCore   = regexp.MustCompile(`method\s+([a-zA-Z_<>]+)\s+([a-zA-Z0-9_]+)\s*\(([^)]*)\)`)
-	reHandlerCore  = regexp.MustCompile(`handler\s+(?:([a-zA-Z_<>]+)\s+)?([a-zA-Z0-9_]+)\s*\(([^)]*)\)`)
-)
+// primitiveTypes는 이벤트 타입이 될 수 없는 Lua 기본 타입입니다.
+var primitiveTypes = map[string]bool{
+	"any":      true,
+	"boolean":  true,
+	"integer":  true,
+	"number":   true,
+	"string":   true,
+	"table":    true,
+	"function": true,
+	"nil":      true,
+}
 
-func parseCommonAttributes(commentBlock string) (desc, execSpace string, params []ParamInfo) {
-	if match := reDesc.FindStringSubmatch(commentBlock); len(match) > 1 {
-		desc = match[1]
+// eventTypeName은 핸들러 파라미터 타입이 이벤트 타입이 될 수 있는 단순한 이름(`DamageEvent`, `DamageEvent?`)이면
+// `?`를 뗀 이름을 반환합니다. 제네릭, 유니온, 배열과 Lua 기본 타입은 이벤트가 아닙니다.
+func eventTypeName(typeName string) (string, bool) {
+	t, err := ParseTypeExpr(typeName)
+	if err != nil {
+		return "", false
+	}
+	if t.Kind == TypeNullable {
+		t = t.Elem
 	}
-	if match := reExecSpace.FindStringSubmatch(commentBlock); len(match) > 1 {
-		execSpace = match[1]
+	if t.Kind != TypeNamed || len(t.Args) > 0 || primitiveTypes[t.Name] {
+		return "", false
 	}
+	return t.Name, true
+}
 
-	paramMatches := reParam.FindAllStringSubmatch(commentBlock, -1)
-	for _, pMatch := range paramMatches {
-		params = append(params, ParamInfo{
-			Type:        pMatch[2],
-			Name:        pMatch[1],
-			Description: strings.TrimSpace(pMatch[3]),
-		})
+// propertyAttributes는 프로퍼티에만 의미가 있는 동기화/인스펙터 어트리뷰트입니다.
+var propertyAttributes = map[string]bool{
+	"Sync":              true,
+	"TargetUserSync":    true,
+	"HideFromInspector": true,
+}
+
+// checkAttributes는 선언 종류에 맞지 않는 어트리뷰트를 경고로 남깁니다.
+func checkAttributes(attrs []attributeNode, kind string, diags *Diagnostics) {
+	for _, a := range attrs {
+		if a.name == "EventSender" && kind != "handler" {
+			diags.add(SeverityWarning, CodeMisplacedAttribute, a.pos, "@EventSender는 handler에만 쓸 수 있습니다")
+		}
+		if propertyAttributes[a.name] && kind != "property" {
+			diags.add(SeverityWarning, CodeMisplacedAttribute, a.pos, "@%s는 property에만 쓸 수 있습니다", a.name)
+		}
 	}
-	return
 }
 
-func parseSignatureParams(paramStr string) []ParamInfo {
-	paramStr = strings.TrimSpace(paramStr)
-	if paramStr == "" {
-		return nil
+// attributes는 어트리뷰트 노드를 공개 모델로 바꿉니다.
+func attributes(nodes []attributeNode) []Attribute {
+	var attrs []Attribute
+	for _, n := range nodes {
+		attrs = append(attrs, Attribute{Name: n.name, Args: n.args})
 	}
+	return attrs
+}
 
-	var params []ParamInfo
-	parts := strings.Split(paramStr, ",")
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if part == "" {
-			continue
-		}
+// attributeArg는 어트리뷰트의 i번째 인자를 반환합니다. 없으면 빈 문자열입니다.
+// ExecSpace, EventSender처럼 어트리뷰트에서 뽑아낸 편의 필드를 채우는 데 씁니다.
+func attributeArg(attrs []Attribute, name string, i int) string {
+	if a, ok := FindAttribute(attrs, name); ok && i < len(a.Args) {
+		return a.Args[i]
+	}
+	return ""
+}
 
-		fields := strings.Fields(part)
-		if len(fields) >= 2 {
-			params = append(params, ParamInfo{
-				Type: fields[0],
-				Name: fields[1],
-			})
-		}
+func signatureParams(nodes []paramNode) []ParamInfo {
+	var params []ParamInfo
+	for _, n := range nodes {
+		params = append(params, ParamInfo{
+			Type:     n.typ,
+			Name:     n.name,
+			Default:  n.value,
+			Optional: n.optional(),
+		})
 	}
 	return params
 }
@@ -76,11 +120,8 @@ func mergeParamsWithDescriptions(signatureParams, annotationParams []ParamInfo)
 
 	var result []ParamInfo
 	for _, sp := range signatureParams {
-		param := ParamInfo{
-			Type:        sp.Type,
-			Name:        sp.Name,
-			Description: descMap[sp.Name],
-		}
+		param := sp
+		param.Description = descMap[sp.Name]
 
 		// Description의 앞뒤에 "가 붙어있을 경우에만 제거
 		param.Description = strings.Trim(param.Description, `"`)
@@ -91,130 +132,247 @@ func mergeParamsWithDescriptions(signatureParams, annotationParams []ParamInfo)
 	return result
 }
 
+// Parse는 mLua 소스를 파싱하여 첫 번째 스크립트의 문서를 반환합니다.
+// 파일에 스크립트가 여러 개 있으면 ParseAll을, 진단이 필요하면 ParseWithOptions를 사용합니다.
 func Parse(content string) (*Documentation, error) {
-	docs := &Documentation{}
-	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	doc, _, err := ParseWithOptions(content, Options{})
+	return doc, err
+}
 
-	var commentBlock []string
+// ParseWithOptions는 mLua 소스를 파싱하고 첫 번째 스크립트의 문서와 진단 목록을 함께 반환합니다.
+// 오류 수준의 진단이 있으면 Documentation과 함께 error도 반환합니다.
+func ParseWithOptions(content string, opts Options) (*Documentation, Diagnostics, error) {
+	docs, diags, err := ParseAllWithOptions(content, opts)
+	if len(docs) == 0 {
+		return &Documentation{}, diags, err
+	}
+	return docs[0], diags, err
+}
 
-	for _, line := range lines {
-		trimmedLine := strings.TrimSpace(line)
+// ParseAll은 mLua 소스에 있는 스크립트마다 문서를 하나씩 만들어 선언 순서대로 반환합니다.
+func ParseAll(content string) ([]*Documentation, error) {
+	docs, _, err := ParseAllWithOptions(content, Options{})
+	return docs, err
+}
 
-		isDocCommentLine := strings.HasPrefix(trimmedLine, "---@")
-		if isDocCommentLine {
-			commentBlock = append(commentBlock, trimmedLine)
-			continue
-		}
+// ParseAllWithOptions는 ParseAll과 같지만 진단 목록을 함께 반환합니다.
+func ParseAllWithOptions(content string, opts Options) ([]*Documentation, Diagnostics, error) {
+	var diags Diagnostics
+	file := parseSource(strings.ReplaceAll(content, "\r\n", "\n"), &diags)
+	docs := buildDocumentations(file, &diags)
+	diags.sort()
+	opts.apply(diags)
+	return docs, diags, diags.Err()
+}
 
-		if docs.DocType == "" {
-			if match := reDocType.FindStringSubmatch(trimmedLine); len(match) > 1 {
-				docs.DocType = match[1]
-
-				if len(commentBlock) > 0 {
-					commentStr := strings.Join(commentBlock, "\n")
-					if descMatch := reDesc.FindStringSubmatch(commentStr); len(descMatch) > 1 {
-						docs.Description = descMatch[1]
-					}
-					commentBlock = nil
-				}
+// buildDocumentations는 AST의 스크립트마다 Documentation을 만듭니다.
+// 종류 어트리뷰트도 script 헤더도 없이 떠 있는 멤버는 바로 앞 스크립트(앞에 없으면 다음 스크립트)에 넣습니다.
+func buildDocumentations(file *fileNode, diags *Diagnostics) []*Documentation {
+	var docs []*Documentation
+	var pending []memberNode
+
+	for _, script := range file.scripts {
+		attrs := parseCommonAttributes(script.doc, diags)
+		checkParamTags(attrs.params, nil, "script", diags)
+		checkReturnTag(attrs.returns, "", "script", diags)
+		checkAttributes(script.attributes, "script", diags)
+
+		if script.kind == "" && !script.hasHeader {
+			if len(docs) == 0 {
+				pending = append(pending, script.members...)
 				continue
 			}
-		}
-
-		// 문서 주석 라인 (---@ 또는 @로 시작)을 수집
-		isCommentLine := strings.HasPrefix(trimmedLine, "---@") || strings.HasPrefix(trimmedLine, "@ExecSpace") || strings.HasPrefix(trimmedLine, "@EventSender")
-		if isCommentLine {
-			commentBlock = append(commentBlock, trimmedLine)
+			for _, m := range script.members {
+				addMember(docs[len(docs)-1], m, diags)
+			}
 			continue
 		}
 
-		// 실제 코드 라인(property, method, handler)을 만나면 블록 처리
-		isCodeLine := rePropertyCore.MatchString(trimmedLine) ||
-			reMethodCore.MatchString(trimmedLine) ||
-			reHandlerCore.MatchString(trimmedLine)
+		doc := &Documentation{
+			DocType:           script.kind,
+			Name:              script.name,
+			Extends:           script.extends,
+			Description:       attrs.desc,
+			Deprecated:        attrs.deprecated,
+			DeprecatedMessage: attrs.deprecatedMsg,
+			Examples:          attrs.examples,
+			See:               attrs.see,
+			Since:             attrs.since,
+			Version:           attrs.version,
+			Metadata:          attrs.metadata,
+			Attributes:        attributes(script.attributes),
+			Span:              script.span(),
+			DocSpan:           script.doc.span(),
+		}
+		for _, m := range append(pending, script.members...) {
+			addMember(doc, m, diags)
+		}
+		pending = nil
+		docs = append(docs, doc)
+	}
 
-		if isCodeLine {
-			// 현재까지 수집된 주석과 코드 라인을 합쳐서 파싱
-			parseBlock(strings.Join(commentBlock, "\n"), trimmedLine, docs)
-			// 다음 블록을 위해 주석 블록을 초기화
-			commentBlock = nil
+	if len(pending) > 0 {
+		doc := &Documentation{}
+		for _, m := range pending {
+			addMember(doc, m, diags)
 		}
-		// 관련 없는 라인은 무시
+		docs = append(docs, doc)
+	}
+	for _, doc := range docs {
+		doc.Constants = isConstantTable(doc)
 	}
+	return docs
+}
 
-	return docs, nil
+// isConstantTable은 문서를 값/의미 테이블로 보여 줄지 결정합니다. @Enum 스크립트이거나,
+// 메서드와 핸들러 없이 리터럴 기본값을 가진 readonly 프로퍼티만 있는 스크립트가 해당합니다.
+func isConstantTable(doc *Documentation) bool {
+	if doc.DocType == "Enum" {
+		return true
+	}
+	if len(doc.Properties) == 0 || len(doc.Methods) > 0 || len(doc.Handlers) > 0 {
+		return false
+	}
+	for _, p := range doc.Properties {
+		if !p.Constant {
+			return false
+		}
+	}
+	return true
 }
 
-// parseBlock은 수집된 주석 블록과 실제 코드 한 줄을 받아 처리합니다.
-func parseBlock(comment string, code string, docs *Documentation) {
-	desc, execSpace, params := parseCommonAttributes(comment)
+// isLiteral은 기본값이 숫자, 문자열, 불리언 리터럴인지 확인합니다.
+func isLiteral(value string) bool {
+	value = strings.TrimSpace(value)
+	switch {
+	case value == "":
+		return false
+	case value == "true" || value == "false":
+		return true
+	case len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0]:
+		return true
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return true
+	}
+	_, err := strconv.ParseInt(value, 0, 64) // 0x1F 같은 16진수
+	return err == nil
+}
 
-	if propMatch := rePropertyCore.FindStringSubmatch(code); len(propMatch) > 0 {
+// addMember는 멤버 선언 하나를 Documentation의 해당 목록에 추가합니다.
+func addMember(docs *Documentation, m memberNode, diags *Diagnostics) {
+	d := m.decl()
+	attrs := parseCommonAttributes(d.doc, diags)
+	attrList := attributes(d.attributes)
+	execSpace := attributeArg(attrList, "ExecSpace", 0)
+
+	switch n := m.(type) {
+	case *propertyNode:
+		checkParamTags(attrs.params, nil, "property "+n.name, diags)
+		checkReturnTag(attrs.returns, "", "property "+n.name, diags)
+		checkVersionTag(attrs, "property "+n.name, diags)
+		checkAttributes(d.attributes, "property", diags)
 		docs.Properties = append(docs.Properties, PropertyDoc{
-			Description:  desc,
-			ExecSpace:    execSpace,
-			Type:         propMatch[1],
-			Name:         propMatch[2],
-			DefaultValue: strings.Trim(propMatch[3], `"`),
+			Description:       attrs.desc,
+			ExecSpace:         execSpace,
+			Type:              n.typ,
+			Name:              n.name,
+			DefaultValue:      strings.Trim(n.value, `"`),
+			ReadOnly:          d.hasModifier("readonly"),
+			Constant:          d.hasModifier("readonly") && isLiteral(n.value),
+			Attributes:        attrList,
+			Deprecated:        attrs.deprecated,
+			DeprecatedMessage: attrs.deprecatedMsg,
+			Examples:          attrs.examples,
+			See:               attrs.see,
+			Since:             attrs.since,
+			Metadata:          attrs.metadata,
+			Span:              d.span(),
+			DocSpan:           d.doc.span(),
 		})
-	} else if methodMatch := reMethodCore.FindStringSubmatch(code); len(methodMatch) > 0 {
-		// method에 붙은 @ExecSpace는 주석이 아닌 코드 라인과 붙어있을 수 있음
-		if execSpace == "" {
-			if match := reExecSpace.FindStringSubmatch(code); len(match) > 1 {
-				execSpace = match[1]
-			}
-		}
-
-		signatureParams := parseSignatureParams(methodMatch[3])
-		finalParams := mergeParamsWithDescriptions(signatureParams, params)
-
+	case *methodNode:
+		checkParamTags(attrs.params, n.params, "method "+n.name, diags)
+		checkReturnTag(attrs.returns, n.returnType, "method "+n.name, diags)
+		checkVersionTag(attrs, "method "+n.name, diags)
+		checkAttributes(d.attributes, "method", diags)
 		docs.Methods = append(docs.Methods, MethodDoc{
-			Description: desc,
-			ExecSpace:   execSpace,
-			Params:      finalParams,
-			ReturnType:  methodMatch[1],
-			Name:        methodMatch[2],
+			Description:       attrs.desc,
+			ExecSpace:         execSpace,
+			Params:            mergeParamsWithDescriptions(signatureParams(n.params), attrs.paramInfos()),
+			ReturnType:        n.returnType,
+			ReturnDescription: attrs.returnDescription(),
+			Deprecated:        attrs.deprecated,
+			DeprecatedMessage: attrs.deprecatedMsg,
+			Examples:          attrs.examples,
+			See:               attrs.see,
+			Since:             attrs.since,
+			Metadata:          attrs.metadata,
+			Attributes:        attrList,
+			Static:            d.hasModifier("static"),
+			Local:             d.hasModifier("local"),
+			Lifecycle:         lifecycleMethods[n.name] && !d.hasModifier("local"),
+			Name:              n.name,
+			Span:              d.span(),
+			DocSpan:           d.doc.span(),
 		})
-	} else if handlerMatch := reHandlerCore.FindStringSubmatch(code); len(handlerMatch) > 0 {
-		// handler도 마찬가지
-		if execSpace == "" {
-			if match := reExecSpace.FindStringSubmatch(code); len(match) > 1 {
-				execSpace = match[1]
-			}
-		}
-		eventSenderType := ""
-		eventSenderValue := ""
-		if match := reEventSender.FindStringSubmatch(comment); len(match) > 1 {
-			eventSenderType = match[1]
-			if len(match) > 2 && match[2] != "" {
-				eventSenderValue = match[2]
-			}
-		}
+	case *handlerNode:
+		checkParamTags(attrs.params, n.params, "handler "+n.name, diags)
+		checkReturnTag(attrs.returns, n.returnType, "handler "+n.name, diags)
+		checkVersionTag(attrs, "handler "+n.name, diags)
+		checkAttributes(d.attributes, "handler", diags)
 		returnType := "handler"
-		handlerName := handlerMatch[2]
-		if handlerMatch[1] != "" {
-			returnType = handlerMatch[1]
+		if n.returnType != "" {
+			returnType = n.returnType
 		}
+		params := mergeParamsWithDescriptions(signatureParams(n.params), attrs.paramInfos())
 
-		signatureParams := parseSignatureParams(handlerMatch[3])
-		finalParams := mergeParamsWithDescriptions(signatureParams, params)
+		// `handler OnDamage(DamageEvent event)`처럼 파라미터가 하나이면 그 파라미터가 구독하는 이벤트입니다.
+		var eventType, eventVar string
+		if len(params) == 1 {
+			if name, ok := eventTypeName(params[0].Type); ok {
+				eventType, eventVar = name, params[0].Name
+			}
+		}
 
 		docs.Handlers = append(docs.Handlers, HandlerDoc{
-			Description:      desc,
-			ExecSpace:        execSpace,
-			EventSenderType:  eventSenderType,
-			EventSenderValue: eventSenderValue,
-			Name:             handlerName,
-			ReturnType:       returnType,
-			Params:           finalParams,
+			Description:       attrs.desc,
+			ExecSpace:         execSpace,
+			EventSenderType:   attributeArg(attrList, "EventSender", 0),
+			EventSenderValue:  attributeArg(attrList, "EventSender", 1),
+			Name:              n.name,
+			EventType:         eventType,
+			EventVar:          eventVar,
+			ReturnType:        returnType,
+			ReturnDescription: attrs.returnDescription(),
+			Deprecated:        attrs.deprecated,
+			DeprecatedMessage: attrs.deprecatedMsg,
+			Examples:          attrs.examples,
+			See:               attrs.see,
+			Since:             attrs.since,
+			Metadata:          attrs.metadata,
+			Attributes:        attrList,
+			Params:            params,
+			Span:              d.span(),
+			DocSpan:           d.doc.span(),
 		})
 	}
 }
 
-func ParseFile(filepath string) (*Documentation, error) {
+// ParseFile은 파일을 읽어 스크립트마다 문서를 하나씩 반환합니다.
+func ParseFile(filepath string) ([]*Documentation, error) {
+	docs, _, err := ParseFileWithOptions(filepath, Options{})
+	return docs, err
+}
+
+// ParseFileWithOptions는 파일을 읽어 ParseAllWithOptions로 파싱하고 진단에 파일 경로를 채웁니다.
+func ParseFileWithOptions(filepath string, opts Options) ([]*Documentation, Diagnostics, error) {
 	content, err := os.ReadFile(filepath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	docs, diags, _ := ParseAllWithOptions(string(content), opts)
+	for i := range diags {
+		diags[i].File = filepath
 	}
-	return Parse(string(content))
+	return docs, diags, diags.Err()
 }
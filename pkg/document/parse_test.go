@@ -1,6 +1,7 @@
 package document
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -214,3 +215,1000 @@ handler MyHandler(string message, number code)`
 		t.Errorf("Param[1].Name = %v, want code", handler.Params[1].Name)
 	}
 }
+
+func TestParseScriptWithBodies(t *testing.T) {
+	input := `---@description "Game logic"
+@Logic
+script GameLogic extends Logic
+
+	property number speed = 1.5
+
+	---@description "Moves the player"
+	@ExecSpace("ServerOnly")
+	method void Move(
+		Vector3 direction,
+		number scale = 1.0
+	)
+		local method = "method void Fake()"
+		if scale > 1 then
+			for i = 1, 3 do
+				self:Step(function() return i end)
+			end
+		end
+	end
+
+	handler OnTick(number delta)
+		-- method void NotAMethod()
+		while true do
+			break
+		end
+	end
+end`
+
+	doc, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if doc.DocType != "Logic" {
+		t.Errorf("DocType = %v, want Logic", doc.DocType)
+	}
+	if doc.Description != "Game logic" {
+		t.Errorf("Description = %v, want Game logic", doc.Description)
+	}
+	if len(doc.Properties) != 1 || doc.Properties[0].Name != "speed" || doc.Properties[0].DefaultValue != "1.5" {
+		t.Errorf("Properties = %+v, want single speed property with default 1.5", doc.Properties)
+	}
+	if len(doc.Methods) != 1 {
+		t.Fatalf("Expected 1 method, got %d: %+v", len(doc.Methods), doc.Methods)
+	}
+
+	method := doc.Methods[0]
+	if method.Name != "Move" || method.ReturnType != "void" || method.ExecSpace != "ServerOnly" {
+		t.Errorf("Method = %+v, want void Move with ServerOnly", method)
+	}
+	if len(method.Params) != 2 {
+		t.Fatalf("Expected 2 parameters, got %d", len(method.Params))
+	}
+	if method.Params[1].Name != "scale" || method.Params[1].Type != "number" {
+		t.Errorf("Param[1] = %+v, want number scale", method.Params[1])
+	}
+
+	if len(doc.Handlers) != 1 || doc.Handlers[0].Name != "OnTick" {
+		t.Errorf("Handlers = %+v, want single OnTick handler", doc.Handlers)
+	}
+}
+
+func TestParseDeclarationsWithoutEnd(t *testing.T) {
+	input := `@Logic
+script GameLogic extends Logic
+
+	---@description "Called on connect"
+	---@param playerName string "Player name"
+	@EventSender("Logic", "AuthLogic")
+	handler OnPlayerConnect(string playerName)
+
+	---@description "Sends a message"
+	@ExecSpace("ServerOnly")
+	method void SendMessageToServer(string message)
+end`
+
+	doc, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(doc.Handlers) != 1 || len(doc.Methods) != 1 {
+		t.Fatalf("Expected 1 handler and 1 method, got %d and %d", len(doc.Handlers), len(doc.Methods))
+	}
+	if doc.Handlers[0].Params[0].Description != "Player name" {
+		t.Errorf("Param description = %v, want Player name", doc.Handlers[0].Params[0].Description)
+	}
+	if doc.Methods[0].Description != "Sends a message" {
+		t.Errorf("Method description = %v, want Sends a message", doc.Methods[0].Description)
+	}
+}
+
+func TestParseReadmeExampleClosesScript(t *testing.T) {
+	input := `    ---@description "게임을 관리하는 로직 입니다."
+    @Logic
+    script GameLogic extends Logic
+
+        ---@description "플레이어 접속 시 호출"
+        ---@param playerName string "접속한 플레이어 이름"
+        @EventSender("Logic", "AuthLogic")
+        handler OnPlayerConnect(string playerName)
+
+        ---@description "서버에 메시지를 전송합니다."
+        ---@param message string "전송할 메시지"
+        @ExecSpace("ServerOnly")
+        method void SendMessageToServer(string message)
+    end
+`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+	if len(doc.Handlers) != 1 || len(doc.Methods) != 1 {
+		t.Fatalf("Expected 1 handler and 1 method, got %d and %d", len(doc.Handlers), len(doc.Methods))
+	}
+	for _, d := range diags {
+		if d.Code == CodeMissingEnd && d.Pos.Line == 3 {
+			t.Errorf("Unexpected missing-end diagnostic for the script: %v", d)
+		}
+	}
+	if got := doc.Span.End.Line; got != 14 {
+		t.Errorf("Script span ends at line %d, want 14", got)
+	}
+}
+
+func TestParseGenericParameterTypes(t *testing.T) {
+	input := `@Struct
+script Inventory
+	method void SetItems(table<string, table<string, number>> items, any? owner)
+	end
+end`
+
+	doc, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(doc.Methods) != 1 {
+		t.Fatalf("Expected 1 method, got %d", len(doc.Methods))
+	}
+	params := doc.Methods[0].Params
+	if len(params) != 2 {
+		t.Fatalf("Expected 2 parameters, got %d: %+v", len(params), params)
+	}
+	if params[0].Type != "table<string, table<string, number>>" || params[0].Name != "items" {
+		t.Errorf("Param[0] = %+v, want table<string, table<string, number>> items", params[0])
+	}
+	if params[1].Type != "any?" || params[1].Name != "owner" {
+		t.Errorf("Param[1] = %+v, want any? owner", params[1])
+	}
+}
+
+func TestParseSourcePositions(t *testing.T) {
+	input := `---@description "Game logic"
+@Logic
+script GameLogic extends Logic
+
+	---@description "Speed"
+	property number speed = 1
+
+	---@description "Moves"
+	---@param direction Vector3 "Direction"
+	method void Move(Vector3 direction)
+		local x = 1
+	end
+end`
+
+	doc, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if doc.Span.Start.Line != 3 || doc.Span.End.Line != 13 {
+		t.Errorf("script Span = %+v, want lines 3-13", doc.Span)
+	}
+	if doc.DocSpan.Start.Line != 1 || doc.DocSpan.End.Line != 1 {
+		t.Errorf("script DocSpan = %+v, want line 1", doc.DocSpan)
+	}
+
+	prop := doc.Properties[0]
+	if prop.Span.Start != (Pos{Line: 6, Column: 2}) || prop.DocSpan.Start.Line != 5 {
+		t.Errorf("property Span = %+v, DocSpan = %+v, want 6:2 and doc line 5", prop.Span, prop.DocSpan)
+	}
+
+	method := doc.Methods[0]
+	if method.Span.Start.Line != 10 || method.Span.End.Line != 12 {
+		t.Errorf("method Span = %+v, want lines 10-12", method.Span)
+	}
+	if method.DocSpan.Start.Line != 8 || method.DocSpan.End.Line != 9 {
+		t.Errorf("method DocSpan = %+v, want lines 8-9", method.DocSpan)
+	}
+}
+
+func TestParseDiagnostics(t *testing.T) {
+	input := `@Logic
+script GameLogic extends Logic
+
+	---@description "Unterminated
+	---@param missing string "Not in signature"
+	@EventSender("Entity")
+	method void Move(number speed)
+	end
+
+	---@param dangling string "Nobody owns this"
+end`
+
+	tests := []struct {
+		code     string
+		line     int
+		severity Severity
+	}{
+		{CodeUnterminatedDescription, 4, SeverityWarning},
+		{CodeOrphanParam, 5, SeverityWarning},
+		{CodeMisplacedAttribute, 6, SeverityWarning},
+		{CodeOrphanDoc, 10, SeverityWarning},
+	}
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+	if len(doc.Methods) != 1 {
+		t.Fatalf("Expected 1 method, got %d", len(doc.Methods))
+	}
+
+	for _, tt := range tests {
+		found := false
+		for _, d := range diags {
+			if d.Code == tt.code && d.Pos.Line == tt.line && d.Severity == tt.severity {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected %s %s at line %d, got %v", tt.severity, tt.code, tt.line, diags)
+		}
+	}
+}
+
+func TestParseStrictMode(t *testing.T) {
+	input := `@Logic
+script GameLogic extends Logic
+	---@param ghost string "Not in signature"
+	method void Run()
+	end
+end`
+
+	if _, err := Parse(input); err != nil {
+		t.Fatalf("Parse() error = %v, want nil in non-strict mode", err)
+	}
+
+	_, diags, err := ParseWithOptions(input, Options{Strict: true})
+	if err == nil {
+		t.Fatal("ParseWithOptions() error = nil, want error in strict mode")
+	}
+	if !diags.HasErrors() {
+		t.Errorf("Expected warnings to be promoted to errors, got %v", diags)
+	}
+}
+
+func TestParseInvalidDeclaration(t *testing.T) {
+	input := `@Logic
+script GameLogic extends Logic
+	method void Broken(string
+	method void Fine()
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err == nil {
+		t.Fatal("ParseWithOptions() error = nil, want invalid declaration error")
+	}
+	if len(diags) == 0 || diags[0].Code != CodeInvalidDeclaration || diags[0].Pos.Line != 3 {
+		t.Errorf("Expected invalid-declaration at line 3, got %v", diags)
+	}
+	if len(doc.Methods) != 1 || doc.Methods[0].Name != "Fine" {
+		t.Errorf("Methods = %+v, want only Fine", doc.Methods)
+	}
+}
+
+func TestParseScriptHeader(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           string
+		expectedName    string
+		expectedExtends string
+		expectedType    string
+	}{
+		{
+			name: "Header with extends",
+			input: `@Component
+script PlayerMover extends Component
+end`,
+			expectedName:    "PlayerMover",
+			expectedExtends: "Component",
+			expectedType:    "Component",
+		},
+		{
+			name: "Header extending another script",
+			input: `@Component
+script BossMover extends PlayerMover
+end`,
+			expectedName:    "BossMover",
+			expectedExtends: "PlayerMover",
+			expectedType:    "Component",
+		},
+		{
+			name: "No header",
+			input: `@Logic
+handler TestHandler()`,
+			expectedName:    "",
+			expectedExtends: "",
+			expectedType:    "Logic",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if doc.Name != tt.expectedName {
+				t.Errorf("Name = %v, want %v", doc.Name, tt.expectedName)
+			}
+			if doc.Extends != tt.expectedExtends {
+				t.Errorf("Extends = %v, want %v", doc.Extends, tt.expectedExtends)
+			}
+			if doc.DocType != tt.expectedType {
+				t.Errorf("DocType = %v, want %v", doc.DocType, tt.expectedType)
+			}
+		})
+	}
+}
+
+func TestParseMultiLineDescription(t *testing.T) {
+	input := `---@description "Manages the game.
+--- Supports \"quoted\" words and Markdown:
+---
+--- - item one
+--- ` + "```lua" + `
+---   local x = 1
+--- ` + "```" + `"
+@Logic
+script GameLogic extends Logic
+	---@description
+	--- Free-form text
+	--- on two lines
+	---@param speed number "How fast"
+	method void Move(number speed)
+	end
+
+	--- Untagged description.
+	property number hp = 10
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+	if len(diags) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diags)
+	}
+
+	want := "Manages the game.\nSupports \"quoted\" words and Markdown:\n\n- item one\n```lua\n  local x = 1\n```"
+	if doc.Description != want {
+		t.Errorf("Description = %q, want %q", doc.Description, want)
+	}
+	if doc.Methods[0].Description != "Free-form text\non two lines" {
+		t.Errorf("Method description = %q, want two free-form lines", doc.Methods[0].Description)
+	}
+	if doc.Methods[0].Params[0].Description != "How fast" {
+		t.Errorf("Param description = %q, want How fast", doc.Methods[0].Params[0].Description)
+	}
+	if doc.Properties[0].Description != "Untagged description." {
+		t.Errorf("Property description = %q, want Untagged description.", doc.Properties[0].Description)
+	}
+}
+
+func TestParseReturnAnnotation(t *testing.T) {
+	input := `@Logic
+script Shop extends Logic
+	---@description "Finds an item"
+	---@return Item "The item, or nil when it is sold out"
+	method Item FindItem(string id)
+	end
+
+	---@return number "Mismatched"
+	method string Name()
+	end
+
+	---@return number "Nothing to return"
+	method void Reset()
+	end
+
+	---@return boolean "Whether it was handled"
+	handler boolean OnBuy(string id)
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+
+	if doc.Methods[0].ReturnDescription != "The item, or nil when it is sold out" {
+		t.Errorf("ReturnDescription = %q, want item description", doc.Methods[0].ReturnDescription)
+	}
+	if doc.Handlers[0].ReturnDescription != "Whether it was handled" {
+		t.Errorf("Handler ReturnDescription = %q, want Whether it was handled", doc.Handlers[0].ReturnDescription)
+	}
+
+	codes := make(map[string]int)
+	for _, d := range diags {
+		codes[d.Code] = d.Pos.Line
+	}
+	if codes[CodeReturnMismatch] != 8 {
+		t.Errorf("Expected return-mismatch at line 8, got %v", diags)
+	}
+	if codes[CodeMisplacedTag] != 12 {
+		t.Errorf("Expected misplaced-tag at line 12, got %v", diags)
+	}
+}
+
+func TestParseDeprecated(t *testing.T) {
+	input := `---@deprecated "Use NewLogic instead"
+@Logic
+script OldLogic extends Logic
+	---@deprecated
+	property number speed = 1
+
+	---@description "Old way"
+	---@deprecated "Use ` + "`Send`" + ` instead"
+	method void SendOld()
+	end
+
+	---@deprecated Replaced by
+	--- OnJoin
+	handler OnConnect()
+	end
+end`
+
+	doc, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if !doc.Deprecated || doc.DeprecatedMessage != "Use NewLogic instead" {
+		t.Errorf("script Deprecated = %v %q, want true with message", doc.Deprecated, doc.DeprecatedMessage)
+	}
+	if !doc.Properties[0].Deprecated || doc.Properties[0].DeprecatedMessage != "" {
+		t.Errorf("property Deprecated = %v %q, want true without message", doc.Properties[0].Deprecated, doc.Properties[0].DeprecatedMessage)
+	}
+	if !doc.Methods[0].Deprecated || doc.Methods[0].DeprecatedMessage != "Use `Send` instead" {
+		t.Errorf("method Deprecated = %v %q, want true with message", doc.Methods[0].Deprecated, doc.Methods[0].DeprecatedMessage)
+	}
+	if doc.Methods[0].Description != "Old way" {
+		t.Errorf("method Description = %q, want Old way", doc.Methods[0].Description)
+	}
+	if !doc.Handlers[0].Deprecated || doc.Handlers[0].DeprecatedMessage != "Replaced by\nOnJoin" {
+		t.Errorf("handler Deprecated = %v %q, want true with two-line message", doc.Handlers[0].Deprecated, doc.Handlers[0].DeprecatedMessage)
+	}
+}
+
+func TestParseExamples(t *testing.T) {
+	input := `@Logic
+script Spawner extends Logic
+	---@description "Spawns monsters"
+	---@example Spawning a single monster
+	---    local spawner = _Spawner
+	---    spawner:Spawn("slime", 1)
+	---
+	---    print("done")
+	---@example
+	--- ` + "```lua" + `
+	--- _Spawner:Spawn("boss", 1)
+	--- ` + "```" + `
+	---@param name string "Monster name"
+	method void Spawn(string name, number count)
+	end
+
+	---@example local hp = _Spawner.maxHp
+	property number maxHp = 100
+
+	---@example
+	handler OnSpawned()
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+
+	examples := doc.Methods[0].Examples
+	if len(examples) != 2 {
+		t.Fatalf("Expected 2 examples, got %d: %+v", len(examples), examples)
+	}
+	if examples[0].Title != "Spawning a single monster" {
+		t.Errorf("Example[0].Title = %q, want Spawning a single monster", examples[0].Title)
+	}
+	if want := "local spawner = _Spawner\nspawner:Spawn(\"slime\", 1)\n\nprint(\"done\")"; examples[0].Code != want {
+		t.Errorf("Example[0].Code = %q, want %q", examples[0].Code, want)
+	}
+	if examples[1].Title != "" || examples[1].Code != `_Spawner:Spawn("boss", 1)` {
+		t.Errorf("Example[1] = %+v, want untitled fenced example", examples[1])
+	}
+	if doc.Methods[0].Params[0].Description != "Monster name" {
+		t.Errorf("Param description = %q, want Monster name", doc.Methods[0].Params[0].Description)
+	}
+
+	if len(doc.Properties[0].Examples) != 1 || doc.Properties[0].Examples[0].Code != "local hp = _Spawner.maxHp" {
+		t.Errorf("Property examples = %+v, want one-line example", doc.Properties[0].Examples)
+	}
+
+	if len(doc.Handlers[0].Examples) != 0 {
+		t.Errorf("Handler examples = %+v, want none", doc.Handlers[0].Examples)
+	}
+	if len(diags) != 1 || diags[0].Code != CodeMalformedExample {
+		t.Errorf("Expected a single malformed-example diagnostic, got %v", diags)
+	}
+}
+
+func TestParseSeeAnnotation(t *testing.T) {
+	input := `---@see DamageEvent
+@Logic
+script Combat extends Logic
+	---@see DamageEvent.amount
+	---@see #Heal
+	method void Attack()
+	end
+
+	---@see
+	method void Heal()
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+
+	if len(doc.See) != 1 || doc.See[0].Target != "DamageEvent" || doc.See[0].Pos.Line != 1 {
+		t.Errorf("Script See = %+v, want DamageEvent at line 1", doc.See)
+	}
+	see := doc.Methods[0].See
+	if len(see) != 2 || see[0].Target != "DamageEvent.amount" || see[1].Target != "#Heal" {
+		t.Errorf("Method See = %+v, want DamageEvent.amount and #Heal", see)
+	}
+	if len(diags) != 1 || diags[0].Code != CodeMalformedSee {
+		t.Errorf("Expected a single malformed-see diagnostic, got %v", diags)
+	}
+}
+
+func TestParseSinceAndVersion(t *testing.T) {
+	input := `---@since "1.2.0"
+---@version 1.4.0
+@Logic
+script Shop extends Logic
+	---@since 1.4.0
+	property number discount = 0
+
+	---@since "1.3.1"
+	---@version 2.0
+	method void Buy()
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+
+	if doc.Since != "1.2.0" || doc.Version != "1.4.0" {
+		t.Errorf("Since/Version = %q/%q, want 1.2.0/1.4.0", doc.Since, doc.Version)
+	}
+	if doc.Properties[0].Since != "1.4.0" {
+		t.Errorf("Property Since = %q, want 1.4.0", doc.Properties[0].Since)
+	}
+	if doc.Methods[0].Since != "1.3.1" {
+		t.Errorf("Method Since = %q, want 1.3.1", doc.Methods[0].Since)
+	}
+	if len(diags) != 1 || diags[0].Code != CodeMisplacedTag || diags[0].Pos.Line != 9 {
+		t.Errorf("Expected a single misplaced @version diagnostic at line 9, got %v", diags)
+	}
+}
+
+func TestParseCustomTags(t *testing.T) {
+	input := `---@owner "gameplay-team"
+---@ticket GAME-101
+---@ticket GAME-204
+@Logic
+script Inventory extends Logic
+	---@description "Adds an item"
+	---@perf
+	---@owner items
+	method void AddItem()
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+	if len(diags) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diags)
+	}
+
+	want := map[string]string{"owner": "gameplay-team", "ticket": "GAME-101\nGAME-204"}
+	if !reflect.DeepEqual(doc.Metadata, want) {
+		t.Errorf("Script Metadata = %#v, want %#v", doc.Metadata, want)
+	}
+
+	m := doc.Methods[0]
+	if m.Description != "Adds an item" {
+		t.Errorf("Description = %q, want Adds an item", m.Description)
+	}
+	if want := map[string]string{"perf": "", "owner": "items"}; !reflect.DeepEqual(m.Metadata, want) {
+		t.Errorf("Method Metadata = %#v, want %#v", m.Metadata, want)
+	}
+}
+
+func TestParsePropertyAttributes(t *testing.T) {
+	input := `@Logic
+script Player extends Logic
+	@Sync
+	@HideFromInspector
+	property number hp = 100
+
+	@TargetUserSync
+	@Range(0, "100")
+	@ExecSpace("ServerOnly")
+	property string nickname = ""
+
+	@Sync
+	method void Heal()
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+
+	hp := doc.Properties[0]
+	if !hp.HasAttribute("Sync") || !hp.HasAttribute("HideFromInspector") || hp.HasAttribute("TargetUserSync") {
+		t.Errorf("hp.Attributes = %+v, want Sync and HideFromInspector", hp.Attributes)
+	}
+
+	nickname := doc.Properties[1]
+	want := []Attribute{
+		{Name: "TargetUserSync"},
+		{Name: "Range", Args: []string{"0", "100"}},
+		{Name: "ExecSpace", Args: []string{"ServerOnly"}},
+	}
+	if !reflect.DeepEqual(nickname.Attributes, want) {
+		t.Errorf("nickname.Attributes = %+v, want %+v", nickname.Attributes, want)
+	}
+	if nickname.ExecSpace != "ServerOnly" {
+		t.Errorf("nickname.ExecSpace = %q, want ServerOnly", nickname.ExecSpace)
+	}
+
+	if len(diags) != 1 || diags[0].Code != CodeMisplacedAttribute || diags[0].Pos.Line != 12 {
+		t.Errorf("Expected a single misplaced @Sync diagnostic at line 12, got %v", diags)
+	}
+}
+
+func TestParseAttributesOnEveryDeclaration(t *testing.T) {
+	input := `@Logic
+@Singleton
+script Matchmaker extends Logic
+	@ExecSpace("ServerOnly")
+	@RateLimit("5", "second")
+	method void Enqueue()
+	end
+
+	@EventSender("Service", "InputService")
+	@Experimental
+	handler OnKey(KeyDownEvent event)
+	end
+end`
+
+	doc, _, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+
+	if want := []Attribute{{Name: "Logic"}, {Name: "Singleton"}}; !reflect.DeepEqual(doc.Attributes, want) {
+		t.Errorf("Script Attributes = %+v, want %+v", doc.Attributes, want)
+	}
+
+	m := doc.Methods[0]
+	if want := []Attribute{{Name: "ExecSpace", Args: []string{"ServerOnly"}}, {Name: "RateLimit", Args: []string{"5", "second"}}}; !reflect.DeepEqual(m.Attributes, want) {
+		t.Errorf("Method Attributes = %+v, want %+v", m.Attributes, want)
+	}
+	if m.ExecSpace != "ServerOnly" {
+		t.Errorf("Method ExecSpace = %q, want ServerOnly", m.ExecSpace)
+	}
+
+	h := doc.Handlers[0]
+	if a, ok := FindAttribute(h.Attributes, "Experimental"); !ok || len(a.Args) != 0 {
+		t.Errorf("Handler Attributes = %+v, want Experimental without args", h.Attributes)
+	}
+	if h.EventSenderType != "Service" || h.EventSenderValue != "InputService" {
+		t.Errorf("EventSender = %q/%q, want Service/InputService", h.EventSenderType, h.EventSenderValue)
+	}
+}
+
+func TestParseStaticLocalAndLifecycle(t *testing.T) {
+	input := `@Logic
+script Spawner extends Logic
+	---@description "Shared spawn counter"
+	static method number NextId()
+		local function bump(n)
+			return n + 1
+		end
+		return bump(0)
+	end
+
+	---@description "Clamps a value"
+	local function clamp(value, min, max)
+		return math.max(min, math.min(max, value))
+	end
+
+	method void OnBeginPlay()
+	end
+
+	@ExecSpace("ServerOnly")
+	method void OnUpdate(number delta)
+	end
+
+	method void Spawn()
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+	if len(diags) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diags)
+	}
+
+	type flags struct{ static, local, lifecycle bool }
+	want := map[string]flags{
+		"NextId":      {static: true},
+		"clamp":       {local: true},
+		"OnBeginPlay": {lifecycle: true},
+		"OnUpdate":    {lifecycle: true},
+		"Spawn":       {},
+	}
+	if len(doc.Methods) != len(want) {
+		t.Fatalf("Expected %d methods, got %d: %+v", len(want), len(doc.Methods), doc.Methods)
+	}
+	for _, m := range doc.Methods {
+		if got := (flags{m.Static, m.Local, m.Lifecycle}); got != want[m.Name] {
+			t.Errorf("%s flags = %+v, want %+v", m.Name, got, want[m.Name])
+		}
+	}
+
+	clamp := doc.Methods[1]
+	if clamp.Description != "Clamps a value" || len(clamp.Params) != 3 || clamp.Params[2].Name != "max" {
+		t.Errorf("clamp = %+v, want description and three untyped params", clamp)
+	}
+	if doc.Methods[0].Span.End.Line != 9 {
+		t.Errorf("NextId ends at line %d, want 9 (nested local function must not end it)", doc.Methods[0].Span.End.Line)
+	}
+}
+
+func TestParseAllMultipleScripts(t *testing.T) {
+	input := `---@description "Damage payload"
+@Struct
+script DamageInfo
+	property number amount = 0
+end
+
+---@description "Fired when damaged"
+@Event
+script DamageEvent extends EventType
+	property DamageInfo info
+end
+
+@Logic
+script Combat extends Logic
+	method void Attack()
+	end
+end`
+
+	docs, diags, err := ParseAllWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseAllWithOptions() error = %v", err)
+	}
+	if len(diags) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diags)
+	}
+	if len(docs) != 3 {
+		t.Fatalf("Expected 3 documents, got %d", len(docs))
+	}
+
+	want := []struct {
+		name, docType, desc string
+		props, methods      int
+	}{
+		{"DamageInfo", "Struct", "Damage payload", 1, 0},
+		{"DamageEvent", "Event", "Fired when damaged", 1, 0},
+		{"Combat", "Logic", "", 0, 1},
+	}
+	for i, w := range want {
+		d := docs[i]
+		if d.Name != w.name || d.DocType != w.docType || d.Description != w.desc || len(d.Properties) != w.props || len(d.Methods) != w.methods {
+			t.Errorf("docs[%d] = %s/%s %q (%d properties, %d methods), want %s/%s %q (%d, %d)",
+				i, d.Name, d.DocType, d.Description, len(d.Properties), len(d.Methods), w.name, w.docType, w.desc, w.props, w.methods)
+		}
+	}
+
+	doc, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if doc.Name != "DamageInfo" || len(doc.Properties) != 1 {
+		t.Errorf("Parse() = %s with %d properties, want only the first script", doc.Name, len(doc.Properties))
+	}
+}
+
+func TestParseAllLooseMembers(t *testing.T) {
+	input := `property number before = 1
+
+@Logic
+script A extends Logic
+end
+
+@Logic
+method void Headerless()
+end`
+
+	docs, err := ParseAll(input)
+	if err != nil {
+		t.Fatalf("ParseAll() error = %v", err)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("Expected 2 documents, got %d", len(docs))
+	}
+	if docs[0].Name != "A" || len(docs[0].Properties) != 1 || docs[0].Properties[0].Name != "before" {
+		t.Errorf("docs[0] = %+v, want script A with the leading property", docs[0])
+	}
+	if docs[1].DocType != "Logic" || docs[1].Name != "" || len(docs[1].Methods) != 1 {
+		t.Errorf("docs[1] = %+v, want a headerless Logic document with one method", docs[1])
+	}
+
+	if docs, err := ParseAll(""); err != nil || len(docs) != 0 {
+		t.Errorf("ParseAll(\"\") = %v, %v, want no documents", docs, err)
+	}
+}
+
+func TestParseEnumAndConstants(t *testing.T) {
+	input := `@Enum
+script ItemGrade
+	---@description "Dropped everywhere"
+	property number Common = 0
+	property number Rare = 1
+end
+
+@Struct
+script Limits
+	readonly property number MaxLevel = 200
+	readonly property string Prefix = "lv."
+	readonly property number Mask = 0x1F
+end
+
+@Struct
+script PlayerStats
+	readonly property number hp = 100
+	property number mp = 50
+end
+
+@Struct
+script Defaults
+	readonly property Vector2 origin = Vector2(0, 0)
+end`
+
+	docs, err := ParseAll(input)
+	if err != nil {
+		t.Fatalf("ParseAll() error = %v", err)
+	}
+	if len(docs) != 4 {
+		t.Fatalf("Expected 4 documents, got %d", len(docs))
+	}
+
+	if docs[0].DocType != "Enum" || !docs[0].Constants || docs[0].Properties[0].Description != "Dropped everywhere" {
+		t.Errorf("ItemGrade = %+v, want an Enum constant table", docs[0])
+	}
+	if !docs[1].Constants {
+		t.Error("Limits should be a constant table")
+	}
+	for _, p := range docs[1].Properties {
+		if !p.ReadOnly || !p.Constant {
+			t.Errorf("Limits.%s ReadOnly/Constant = %v/%v, want true/true", p.Name, p.ReadOnly, p.Constant)
+		}
+	}
+	if docs[2].Constants {
+		t.Error("PlayerStats has a writable property and should not be a constant table")
+	}
+	if docs[3].Constants || !docs[3].Properties[0].ReadOnly || docs[3].Properties[0].Constant {
+		t.Errorf("Defaults = %+v, non-literal readonly default should not be a constant", docs[3])
+	}
+}
+
+func TestParseParamDefaults(t *testing.T) {
+	input := `@Logic
+script Mover extends Logic
+	---@param speed number = 1.0 "Move speed"
+	---@param target? any "Who to follow"
+	---@param label string = "a b" "Label"
+	method void Move(number speed = 1.0, any? target, string label = "a b", number step)
+	end
+
+	---@param speed number = 2 "Wrong default"
+	---@param step? number "Marked optional"
+	---@param extra number = 3 "No default in signature"
+	method void Walk(number speed = 1.0, number step, number extra)
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+
+	want := []ParamInfo{
+		{Name: "speed", Type: "number", Description: "Move speed", Default: "1.0", Optional: true},
+		{Name: "target", Type: "any?", Description: "Who to follow", Optional: true},
+		{Name: "label", Type: "string", Description: "Label", Default: `"a b"`, Optional: true},
+		{Name: "step", Type: "number"},
+	}
+	if !reflect.DeepEqual(doc.Methods[0].Params, want) {
+		t.Errorf("Params = %+v, want %+v", doc.Methods[0].Params, want)
+	}
+
+	if len(diags) != 3 {
+		t.Fatalf("Expected 3 diagnostics, got %v", diags)
+	}
+	for i, line := range []int{9, 10, 11} {
+		if diags[i].Code != CodeParamDefaultMismatch || diags[i].Pos.Line != line {
+			t.Errorf("diags[%d] = %v, want param-default-mismatch at line %d", i, diags[i], line)
+		}
+	}
+}
+
+func TestParseHandlerEventBinding(t *testing.T) {
+	input := `@Logic
+script Combat extends Logic
+	handler HandleDamage(DamageEvent event)
+	end
+
+	handler OnPlayerConnect(string playerName)
+	end
+
+	handler OnMove(Vector3 from, Vector3 to)
+	end
+
+	handler OnEvt(DamageEvent? evt)
+	end
+
+	handler OnBuy(table<string, Item> items)
+	end
+
+	handler OnList(DamageEvent[] events)
+	end
+end`
+
+	doc, err := Parse(input)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(doc.Handlers) != 6 {
+		t.Fatalf("Expected 6 handlers, got %d", len(doc.Handlers))
+	}
+
+	tests := []struct {
+		name, eventType, eventVar string
+	}{
+		{"HandleDamage", "DamageEvent", "event"},
+		{"OnPlayerConnect", "", ""},
+		{"OnMove", "", ""},
+		{"OnEvt", "DamageEvent", "evt"},
+		{"OnBuy", "", ""},
+		{"OnList", "", ""},
+	}
+	for i, tt := range tests {
+		h := doc.Handlers[i]
+		if h.Name != tt.name || h.EventType != tt.eventType || h.EventVar != tt.eventVar {
+			t.Errorf("Handler %d = (%q, %q, %q), want (%q, %q, %q)", i, h.Name, h.EventType, h.EventVar, tt.name, tt.eventType, tt.eventVar)
+		}
+	}
+}
@@ -0,0 +1,710 @@
+package document
+
+import "strings"
+
+// parser는 토큰 목록을 읽어 mLua 선언 문법의 AST를 만드는 재귀 하강 파서입니다.
+//
+//	file       = { leading ( script | member | statement ) }
+//	script     = "script" Name [ "extends" Name ] { leading ( member | localfunc | statement ) } "end"
+//	member     = { modifier } ( property | method | handler )
+//	property   = "property" type Name [ "=" value ]
+//	method     = "method" type Name params body
+//	localfunc  = "local" "function" Name params body
+//	handler    = "handler" [ type ] Name params body
+//	leading    = { docComment | "@" Name [ "(" args ")" ] }
+//
+// 메서드/핸들러 본문은 해석하지 않고 블록 키워드와 `end`의 짝을 맞춰 건너뜁니다.
+type parser struct {
+	src   string
+	toks  []token
+	i     int
+	diags *Diagnostics
+	// inScript는 script 본문을 읽는 중인지 여부입니다. 본문을 건너뛸 때 스크립트의 `end`를 남겨 두는 데 씁니다.
+	inScript bool
+}
+
+// memberModifiers는 선언 키워드 앞에 올 수 있는 수식어입니다.
+var memberModifiers = map[string]bool{
+	"static":   true,
+	"readonly": true,
+}
+
+// blockOpeners는 `end`(또는 `until`)로 닫히는 Lua 블록 키워드입니다.
+// while/for는 뒤따르는 `do`가 블록을 여므로 포함하지 않습니다.
+var blockOpeners = map[string]bool{
+	"function": true,
+	"if":       true,
+	"do":       true,
+	"repeat":   true,
+}
+
+func parseSource(src string, diags *Diagnostics) *fileNode {
+	p := &parser{src: src, toks: tokenize(src, diags), diags: diags}
+	return p.parseFile()
+}
+
+func (p *parser) peek() token { return p.peekAt(p.i) }
+
+func (p *parser) peekAt(j int) token {
+	if j >= len(p.toks) {
+		return p.toks[len(p.toks)-1]
+	}
+	return p.toks[j]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+// prev는 마지막으로 소비한 토큰을 반환합니다.
+func (p *parser) prev() token {
+	if p.i == 0 {
+		return p.toks[0]
+	}
+	return p.toks[p.i-1]
+}
+
+func isWord(t token, word string) bool {
+	return t.kind == tokIdent && t.text == word
+}
+
+func isPunct(t token, punct string) bool {
+	return t.kind == tokPunct && t.text == punct
+}
+
+func (p *parser) acceptPunct(punct string) bool {
+	if isPunct(p.peek(), punct) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectIdent() (token, bool) {
+	if t := p.peek(); t.kind == tokIdent {
+		return p.next(), true
+	}
+	return token{}, false
+}
+
+// skipLine은 현재 줄의 남은 토큰을 버립니다. 선언을 해석하지 못했을 때의 복구 수단입니다.
+func (p *parser) skipLine() {
+	line := p.prev().endPos.Line
+	for t := p.peek(); t.kind != tokEOF && t.pos.Line == line; t = p.peek() {
+		p.next()
+	}
+}
+
+func (p *parser) parseFile() *fileNode {
+	file := &fileNode{}
+	var current *scriptNode
+
+	for {
+		lead := p.parseLeading()
+		t := p.peek()
+
+		switch {
+		case isWord(t, "script") && p.peekAt(p.i+1).kind == tokIdent:
+			file.scripts = append(file.scripts, p.parseScript(lead))
+			current = nil
+		case p.memberAhead(p.i):
+			// 헤더 없이 `@Logic` 등이 멤버 앞에 온 경우 그 지점에서 스크립트가 시작된 것으로 봅니다.
+			if idx := lastKindIndex(lead); idx >= 0 {
+				current = newHeaderlessScript(lead[:idx+1])
+				file.scripts = append(file.scripts, current)
+				lead = lead[idx+1:]
+			}
+			if current == nil {
+				current = &scriptNode{start: t.pos}
+				file.scripts = append(file.scripts, current)
+			}
+			if m := p.parseMember(lead); m != nil {
+				current.members = append(current.members, m)
+				current.end = m.decl().end
+			}
+		default:
+			if idx := lastKindIndex(lead); idx >= 0 {
+				current = newHeaderlessScript(lead[:idx+1])
+				file.scripts = append(file.scripts, current)
+				lead = lead[idx+1:]
+			}
+			p.reportOrphan(lead)
+			if t.kind == tokEOF {
+				return file
+			}
+			p.skipStatement()
+		}
+	}
+}
+
+// leadingItem은 선언 앞에 붙는 문서 주석 블록 또는 어트리뷰트 하나입니다.
+type leadingItem struct {
+	doc  *docNode
+	attr *attributeNode
+}
+
+func (p *parser) parseLeading() []leadingItem {
+	var items []leadingItem
+	for {
+		t := p.peek()
+		switch {
+		case t.kind == tokDoc:
+			items = append(items, leadingItem{doc: p.parseDoc()})
+		case isPunct(t, "@") && p.peekAt(p.i+1).kind == tokIdent:
+			attr := p.parseAttribute()
+			items = append(items, leadingItem{attr: &attr})
+		default:
+			return items
+		}
+	}
+}
+
+// parseDoc은 연속된 줄의 `---` 주석을 하나의 docNode로 묶습니다.
+func (p *parser) parseDoc() *docNode {
+	first := p.next()
+	doc := &docNode{start: first.pos, end: first.endPos}
+	doc.lines = append(doc.lines, docLine{text: first.text, pos: first.pos})
+	for t := p.peek(); t.kind == tokDoc && t.pos.Line == doc.end.Line+1; t = p.peek() {
+		p.next()
+		doc.lines = append(doc.lines, docLine{text: t.text, pos: t.pos})
+		doc.end = t.endPos
+	}
+	return doc
+}
+
+func (p *parser) parseAttribute() attributeNode {
+	at := p.next()
+	name := p.next()
+	attr := attributeNode{name: name.text, pos: at.pos}
+
+	if open := p.peek(); isPunct(open, "(") && open.pos.Line == name.pos.Line {
+		p.next()
+		for {
+			arg, ok := p.parseExpr(",", ")")
+			if arg != "" {
+				attr.args = append(attr.args, unquote(arg))
+			}
+			if !ok || p.acceptPunct(")") {
+				break
+			}
+			p.acceptPunct(",")
+		}
+	}
+	return attr
+}
+
+// splitLeading은 leadingItem 목록을 하나의 문서 주석과 어트리뷰트 목록으로 합칩니다.
+func splitLeading(items []leadingItem) (*docNode, []attributeNode) {
+	var doc *docNode
+	var attrs []attributeNode
+	for _, item := range items {
+		if item.attr != nil {
+			attrs = append(attrs, *item.attr)
+			continue
+		}
+		if doc == nil {
+			doc = &docNode{start: item.doc.start}
+		}
+		doc.lines = append(doc.lines, item.doc.lines...)
+		doc.end = item.doc.end
+	}
+	return doc, attrs
+}
+
+// lastKindIndex는 스크립트 종류 어트리뷰트(@Logic 등) 중 마지막 것의 위치를 반환합니다.
+func lastKindIndex(items []leadingItem) int {
+	for i := len(items) - 1; i >= 0; i-- {
+		if items[i].attr != nil && scriptKinds[items[i].attr.name] {
+			return i
+		}
+	}
+	return -1
+}
+
+func newHeaderlessScript(lead []leadingItem) *scriptNode {
+	s := &scriptNode{}
+	s.doc, s.attributes = splitLeading(lead)
+	s.kind = scriptKind(s.attributes)
+	s.start = s.attributes[len(s.attributes)-1].pos
+	s.end = s.start
+	return s
+}
+
+func scriptKind(attrs []attributeNode) string {
+	for _, a := range attrs {
+		if scriptKinds[a.name] {
+			return a.name
+		}
+	}
+	return ""
+}
+
+func (p *parser) parseScript(lead []leadingItem) *scriptNode {
+	kw := p.next()
+	name := p.next()
+	s := &scriptNode{name: name.text, hasHeader: true, start: kw.pos}
+	s.doc, s.attributes = splitLeading(lead)
+	s.kind = scriptKind(s.attributes)
+	if isWord(p.peek(), "extends") {
+		p.next()
+		if base, ok := p.expectIdent(); ok {
+			s.extends = base.text
+		}
+	}
+	s.end = p.prev().endPos
+
+	p.inScript = true
+	defer func() { p.inScript = false }()
+	for {
+		lead := p.parseLeading()
+		t := p.peek()
+		switch {
+		case t.kind == tokEOF:
+			p.reportOrphan(lead)
+			p.diags.add(SeverityInfo, CodeMissingEnd, s.start, "script %s에 닫는 end가 없습니다", s.name)
+			return s
+		case isWord(t, "end"):
+			p.reportOrphan(lead)
+			s.end = p.next().endPos
+			return s
+		case p.memberAhead(p.i) || p.localFunctionAhead(p.i):
+			if m := p.parseMember(lead); m != nil {
+				s.members = append(s.members, m)
+			}
+			s.end = p.prev().endPos
+		default:
+			p.reportOrphan(lead)
+			p.skipStatement()
+			s.end = p.prev().endPos
+		}
+	}
+}
+
+// memberAhead는 j 위치부터 멤버 선언(`[static] method void Foo(`)이 시작되는지 확인합니다.
+// 선언 키워드 뒤에 같은 줄의 식별자가 와야 하므로 `local method = 1` 같은 코드는 제외됩니다.
+func (p *parser) memberAhead(j int) bool {
+	line := p.peekAt(j).pos.Line
+	for t := p.peekAt(j); t.kind == tokIdent && memberModifiers[t.text] && t.pos.Line == line; t = p.peekAt(j) {
+		j++
+	}
+	kw, nameTok := p.peekAt(j), p.peekAt(j+1)
+	if kw.kind != tokIdent || kw.pos.Line != line || nameTok.kind != tokIdent || nameTok.pos.Line != line {
+		return false
+	}
+	switch kw.text {
+	case "property", "method", "handler":
+		return true
+	}
+	return false
+}
+
+// localFunctionAhead는 j 위치부터 스크립트 본문의 `local function Name(`이 시작되는지 확인합니다.
+// 메서드 본문 안의 local function은 선언이 아니므로 declarationAhead에서는 쓰지 않습니다.
+func (p *parser) localFunctionAhead(j int) bool {
+	local, fn, name := p.peekAt(j), p.peekAt(j+1), p.peekAt(j+2)
+	return isWord(local, "local") && isWord(fn, "function") && name.kind == tokIdent &&
+		fn.pos.Line == local.pos.Line && name.pos.Line == local.pos.Line && isPunct(p.peekAt(j+3), "(")
+}
+
+// declarationAhead는 j 위치부터 문서 주석/어트리뷰트를 건너뛴 뒤 선언이 시작되는지 확인합니다.
+// 본문의 `end`가 빠진 선언을 만났을 때 다음 선언을 본문으로 삼키지 않기 위해 사용합니다.
+func (p *parser) declarationAhead(j int) bool {
+	for {
+		t := p.peekAt(j)
+		switch {
+		case t.kind == tokDoc:
+			j++
+		case isPunct(t, "@") && p.peekAt(j+1).kind == tokIdent:
+			j += 2
+			if open := p.peekAt(j); isPunct(open, "(") && open.pos.Line == t.pos.Line {
+				for depth := 0; ; j++ {
+					tt := p.peekAt(j)
+					if tt.kind == tokEOF {
+						return false
+					}
+					if isPunct(tt, "(") {
+						depth++
+					} else if isPunct(tt, ")") {
+						depth--
+						if depth == 0 {
+							j++
+							break
+						}
+					}
+				}
+			}
+		case isWord(t, "script") && p.peekAt(j+1).kind == tokIdent && p.peekAt(j+1).pos.Line == t.pos.Line:
+			return true
+		default:
+			return p.memberAhead(j)
+		}
+	}
+}
+
+func (p *parser) parseMember(lead []leadingItem) memberNode {
+	decl := declNode{start: p.peek().pos}
+	decl.doc, decl.attributes = splitLeading(lead)
+	for t := p.peek(); t.kind == tokIdent && memberModifiers[t.text]; t = p.peek() {
+		decl.modifiers = append(decl.modifiers, p.next().text)
+	}
+	if p.localFunctionAhead(p.i) {
+		decl.modifiers = append(decl.modifiers, p.next().text)
+	}
+
+	kw := p.next()
+	after := p.i
+	switch kw.text {
+	case "property":
+		node := &propertyNode{declNode: decl}
+		typ, ok := p.parseType()
+		name, okName := p.expectIdent()
+		if !ok || !okName {
+			p.invalidDeclaration(kw, after)
+			return nil
+		}
+		node.typ, node.name = typ, name.text
+		if p.acceptPunct("=") {
+			node.value = p.parseValue()
+		}
+		node.end = p.prev().endPos
+		return node
+
+	case "method", "function":
+		// local function은 반환 타입이 없는 메서드로 다룹니다.
+		node := &methodNode{declNode: decl}
+		typ, name, params, ok := p.parseSignature()
+		if !ok {
+			p.invalidDeclaration(kw, after)
+			return nil
+		}
+		node.returnType, node.name, node.params = typ, name, params
+		node.end = p.parseBody(kw, name, decl.start.Column)
+		return node
+
+	case "handler":
+		node := &handlerNode{declNode: decl}
+		typ, name, params, ok := p.parseSignature()
+		if !ok {
+			p.invalidDeclaration(kw, after)
+			return nil
+		}
+		node.returnType, node.name, node.params = typ, name, params
+		node.end = p.parseBody(kw, name, decl.start.Column)
+		return node
+	}
+	return nil
+}
+
+// parseSignature는 `[type] Name(params)`를 읽습니다. 이름 바로 뒤에 `(`가 오면 타입은 비어 있습니다.
+func (p *parser) parseSignature() (typ, name string, params []paramNode, ok bool) {
+	if isPunct(p.peekAt(p.i+1), "(") {
+		nameTok, _ := p.expectIdent()
+		name = nameTok.text
+	} else {
+		if typ, ok = p.parseType(); !ok {
+			return
+		}
+		nameTok, okName := p.expectIdent()
+		if !okName {
+			return "", "", nil, false
+		}
+		name = nameTok.text
+	}
+	params, ok = p.parseParams()
+	return
+}
+
+// parseType은 타입 표현식을 읽어 원문 그대로 반환합니다. 제네릭(<...>), 배열([]),
+// nullable(?), 유니온(|)을 하나의 타입으로 묶습니다.
+func (p *parser) parseType() (string, bool) {
+	first := p.peek()
+	if first.kind != tokIdent {
+		return "", false
+	}
+	for {
+		if !p.parseTypeAtom() {
+			return "", false
+		}
+		if !p.acceptPunct("|") {
+			break
+		}
+	}
+	return p.src[first.start:p.prev().end], true
+}
+
+func (p *parser) parseTypeAtom() bool {
+	if _, ok := p.expectIdent(); !ok {
+		return false
+	}
+	for isPunct(p.peek(), ".") && p.peekAt(p.i+1).kind == tokIdent {
+		p.next()
+		p.next()
+	}
+	if isPunct(p.peek(), "<") {
+		p.next()
+		for depth := 1; depth > 0; {
+			t := p.next()
+			switch {
+			case t.kind == tokEOF:
+				return false
+			case isPunct(t, "<"):
+				depth++
+			case isPunct(t, ">"):
+				depth--
+			}
+		}
+	}
+	for {
+		switch {
+		case p.acceptPunct("?"):
+		case isPunct(p.peek(), "[") && isPunct(p.peekAt(p.i+1), "]"):
+			p.next()
+			p.next()
+		default:
+			return true
+		}
+	}
+}
+
+// parseParams는 괄호로 둘러싸인 파라미터 목록을 읽습니다.
+func (p *parser) parseParams() ([]paramNode, bool) {
+	if !p.acceptPunct("(") {
+		return nil, false
+	}
+	var params []paramNode
+	if p.acceptPunct(")") {
+		return params, true
+	}
+	for {
+		param, ok := p.parseParam()
+		if !ok {
+			return nil, false
+		}
+		params = append(params, param)
+		if p.acceptPunct(")") {
+			return params, true
+		}
+		if !p.acceptPunct(",") {
+			return nil, false
+		}
+	}
+}
+
+func (p *parser) parseParam() (paramNode, bool) {
+	t := p.peek()
+	param := paramNode{pos: t.pos}
+	if isPunct(t, "...") {
+		p.next()
+		param.name = t.text
+		return param, true
+	}
+
+	typ, ok := p.parseType()
+	if !ok {
+		return param, false
+	}
+	if name, ok := p.expectIdent(); ok {
+		param.typ, param.name = typ, name.text
+	} else {
+		// 타입 없이 이름만 있는 파라미터
+		param.name = typ
+	}
+	if p.acceptPunct("=") {
+		param.value, _ = p.parseExpr(",", ")")
+	}
+	return param, true
+}
+
+// parseExpr는 괄호 깊이 0에서 stops 중 하나를 만날 때까지의 원문을 반환합니다.
+// 종료 토큰은 소비하지 않으며, EOF에 도달하면 ok가 false입니다.
+func (p *parser) parseExpr(stops ...string) (string, bool) {
+	start := p.peek()
+	depth := 0
+	for {
+		t := p.peek()
+		if t.kind == tokEOF {
+			return p.textFrom(start), false
+		}
+		if depth == 0 && t.kind == tokPunct {
+			for _, s := range stops {
+				if t.text == s {
+					return p.textFrom(start), true
+				}
+			}
+		}
+		switch t.text {
+		case "(", "{", "[":
+			if t.kind == tokPunct {
+				depth++
+			}
+		case ")", "}", "]":
+			if t.kind == tokPunct {
+				depth--
+			}
+		}
+		p.next()
+	}
+}
+
+// parseValue는 프로퍼티 기본값을 읽습니다. 괄호가 열려 있지 않다면 같은 줄에서 끝납니다.
+func (p *parser) parseValue() string {
+	start := p.peek()
+	line := start.pos.Line
+	depth := 0
+	for {
+		t := p.peek()
+		if t.kind == tokEOF || (depth == 0 && t.pos.Line != line) {
+			break
+		}
+		if t.kind == tokPunct {
+			switch t.text {
+			case "(", "{", "[":
+				depth++
+			case ")", "}", "]":
+				depth--
+			}
+		}
+		line = t.endPos.Line
+		p.next()
+	}
+	return p.textFrom(start)
+}
+
+// textFrom은 start 토큰부터 마지막으로 소비한 토큰까지의 원문을 반환합니다.
+func (p *parser) textFrom(start token) string {
+	end := p.prev().end
+	if end <= start.start {
+		return ""
+	}
+	return strings.TrimSpace(p.src[start.start:end])
+}
+
+// parseBody는 선언의 본문을 건너뛰고 끝 위치를 반환합니다. 시그니처만 있는 선언은 info 진단을 남깁니다.
+// indent는 선언이 시작된 열입니다.
+func (p *parser) parseBody(kw token, name string, indent int) Pos {
+	end, closed := p.skipBody(indent)
+	if !closed {
+		p.diags.add(SeverityInfo, CodeMissingEnd, kw.pos, "%s %s에 닫는 end가 없습니다", kw.text, name)
+	}
+	return end
+}
+
+// skipBody는 메서드/핸들러 본문을 짝이 맞는 `end`까지 건너뛰고 본문의 끝 위치를 반환합니다.
+// `end`가 없는 시그니처만의 선언도 허용하기 위해, 본문 최상위에서 다음 선언이 보이거나
+// 둘러싼 스크립트를 닫는 `end`가 보이면 그 앞에서 멈춥니다.
+func (p *parser) skipBody(indent int) (Pos, bool) {
+	end := p.prev().endPos
+	for depth := 1; depth > 0; {
+		t := p.peek()
+		if t.kind == tokEOF {
+			return end, false
+		}
+		if depth == 1 && t.first && p.declarationAhead(p.i) {
+			return end, false
+		}
+		if depth == 1 && p.closesScript(t, indent) {
+			return end, false
+		}
+		p.next()
+		end = t.endPos
+		if t.kind != tokIdent {
+			continue
+		}
+		if blockOpeners[t.text] {
+			depth++
+		} else if t.text == "end" || t.text == "until" {
+			depth--
+		}
+	}
+	return end, true
+}
+
+// closesScript는 본문 최상위의 `end`가 선언이 아니라 둘러싼 스크립트를 닫는지 확인합니다.
+// 뒤에 파일 끝이나 다음 script가 오거나, 선언보다 덜 들여 쓴 줄에 있으면 스크립트의 `end`로 봅니다.
+func (p *parser) closesScript(t token, indent int) bool {
+	if !p.inScript || !isWord(t, "end") {
+		return false
+	}
+	next := p.peekAt(p.i + 1)
+	return next.kind == tokEOF || isWord(next, "script") || (t.first && t.pos.Column < indent)
+}
+
+// invalidDeclaration은 해석하지 못한 선언을 오류로 기록하고, 선언 키워드 직후로 되돌아가 그 줄을 건너뜁니다.
+func (p *parser) invalidDeclaration(kw token, after int) {
+	p.diags.add(SeverityError, CodeInvalidDeclaration, kw.pos, "%s 선언을 해석할 수 없습니다", kw.text)
+	p.i = after
+	p.skipLine()
+}
+
+// reportOrphan은 뒤따르는 선언 없이 남은 어트리뷰트와 태그가 있는 문서 주석을 경고로 기록합니다.
+// 태그 없는 `---` 주석은 일반 설명으로 쓰일 수 있으므로 무시합니다.
+func (p *parser) reportOrphan(items []leadingItem) {
+	for _, item := range items {
+		if item.attr != nil {
+			p.diags.add(SeverityWarning, CodeOrphanDoc, item.attr.pos, "@%s 뒤에 선언이 없습니다", item.attr.name)
+			continue
+		}
+		for _, line := range item.doc.lines {
+			if strings.HasPrefix(strings.TrimSpace(line.text), "@") {
+				p.diags.add(SeverityWarning, CodeOrphanDoc, item.doc.start, "문서 주석 뒤에 선언이 없습니다")
+				break
+			}
+		}
+	}
+}
+
+// skipStatement는 선언이 아닌 토큰 하나를 건너뜁니다. 블록을 여는 키워드라면 블록 전체를 건너뜁니다.
+func (p *parser) skipStatement() {
+	t := p.next()
+	if t.kind != tokIdent || !blockOpeners[t.text] {
+		return
+	}
+	for depth := 1; depth > 0; {
+		t := p.next()
+		if t.kind == tokEOF {
+			return
+		}
+		if t.kind != tokIdent {
+			continue
+		}
+		if blockOpeners[t.text] {
+			depth++
+		} else if t.text == "end" || t.text == "until" {
+			depth--
+		}
+	}
+}
+
+// unquote는 문자열 리터럴이면 따옴표를 벗기고 이스케이프를 풀어 반환합니다.
+func unquote(s string) string {
+	if len(s) < 2 {
+		return s
+	}
+	q := s[0]
+	if (q != '"' && q != '\'') || s[len(s)-1] != q {
+		return s
+	}
+	var b strings.Builder
+	body := s[1 : len(s)-1]
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		if c != '\\' || i+1 >= len(body) {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch body[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		default:
+			b.WriteByte(body[i])
+		}
+	}
+	return b.String()
+}
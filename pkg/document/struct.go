@@ -1,25 +1,115 @@
 package document
 
+// Pos는 소스 파일 내 위치입니다. Line과 Column은 1부터 시작하며 Column은 문자(rune) 단위입니다.
+type Pos struct {
+	Line, Column int
+}
+
+// Span은 선언 또는 문서 주석이 차지하는 소스 범위입니다. 범위가 없으면 Start.Line이 0입니다.
+type Span struct {
+	Start, End Pos
+}
+
+// Example은 ---@example로 작성한 사용 예제입니다.
+type Example struct {
+	Title string // @example 뒤 첫 줄의 제목 (생략 가능)
+	Code  string
+}
+
+// SeeRef는 ---@see로 적은 참조 하나입니다. Target은 `Script`, `Script.Member`, `#Member` 형식입니다.
+type SeeRef struct {
+	Target string
+	Pos    Pos
+}
+
+// Attribute는 선언 앞에 붙은 `@Name("a", "b")` 어트리뷰트입니다. 인자의 따옴표는 벗겨져 있습니다.
+type Attribute struct {
+	Name string
+	Args []string
+}
+
 type PropertyDoc struct {
 	Name, Type, Description, DefaultValue, ExecSpace string
+	Deprecated                                       bool   // ---@deprecated가 붙었는지 여부
+	DeprecatedMessage                                string // 대체 API 안내 등 ---@deprecated의 내용
+	Examples                                         []Example
+	See                                              []SeeRef
+	Since                                            string            // ---@since로 적은, 처음 추가된 버전
+	Metadata                                         map[string]string // 파서가 모르는 태그(---@owner 등)의 이름과 내용
+	Attributes                                       []Attribute       // 프로퍼티에 붙은 모든 어트리뷰트 (@Sync, @HideFromInspector 등)
+	ReadOnly                                         bool              // `readonly property`로 선언된 프로퍼티
+	Constant                                         bool              // readonly이면서 기본값이 리터럴인 상수
+	Span, DocSpan                                    Span              // 선언과 문서 주석의 위치
+}
+
+// FindAttribute는 attrs에서 이름이 name인 첫 번째 어트리뷰트를 찾습니다.
+func FindAttribute(attrs []Attribute, name string) (Attribute, bool) {
+	for _, a := range attrs {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return Attribute{}, false
 }
+
+// HasAttribute는 프로퍼티에 이름이 name인 어트리뷰트가 있는지 확인합니다.
+func (p PropertyDoc) HasAttribute(name string) bool {
+	_, ok := FindAttribute(p.Attributes, name)
+	return ok
+}
+
 type ParamInfo struct {
 	Name, Type, Description string // 설명 필드 추가
+	Default                 string // 시그니처의 `= 값` 원문. 없으면 비어 있습니다.
+	Optional                bool   // 기본값이 있거나 타입이 `?`로 끝나는 선택 파라미터
 }
 type MethodDoc struct {
 	Name, ReturnType, Description, ExecSpace string
+	ReturnDescription                        string // ---@return으로 설명한 반환 값
+	Deprecated                               bool
+	DeprecatedMessage                        string
+	Examples                                 []Example
+	See                                      []SeeRef
+	Since                                    string
+	Metadata                                 map[string]string
+	Attributes                               []Attribute // 메서드에 붙은 모든 어트리뷰트. ExecSpace는 여기서 뽑아낸 값입니다.
+	Static                                   bool        // `static method`로 선언된 메서드
+	Local                                    bool        // 스크립트 본문의 `local function` 도우미 함수
+	Lifecycle                                bool        // OnBeginPlay, OnUpdate 등 엔진 생명주기 콜백을 재정의한 메서드
 	Params                                   []ParamInfo
+	Span, DocSpan                            Span
 }
 type HandlerDoc struct {
-	Name, EventType, EventVar, Description, ExecSpace, ReturnType string
-	EventSenderType                                               string      // Type of EventSender (Entity, LocalPlayer, Logic, Self, Model, Service)
-	EventSenderValue                                              string      // Additional value for Logic and Service types
+	Name, EventType, EventVar, Description, ExecSpace, ReturnType string // EventType, EventVar는 파라미터가 하나인 핸들러의 이벤트 타입과 변수 이름
+	EventSenderType                                               string // Type of EventSender (Entity, LocalPlayer, Logic, Self, Model, Service)
+	EventSenderValue                                              string // Additional value for Logic and Service types
+	ReturnDescription                                             string // 반환 타입이 있는 핸들러의 ---@return 설명
+	Deprecated                                                    bool
+	DeprecatedMessage                                             string
+	Examples                                                      []Example
+	See                                                           []SeeRef
+	Since                                                         string
+	Metadata                                                      map[string]string
+	Attributes                                                    []Attribute // 핸들러에 붙은 모든 어트리뷰트. ExecSpace와 EventSender* 필드는 여기서 뽑아낸 값입니다.
 	Params                                                        []ParamInfo // 핸들러도 파라미터를 가질 수 있으므로 추가
+	Span, DocSpan                                                 Span
 }
 type Documentation struct {
-	DocType     string
-	Description string
-	Properties  []PropertyDoc
-	Methods     []MethodDoc
-	Handlers    []HandlerDoc
+	DocType           string
+	Name              string // script 헤더의 스크립트 이름. 헤더가 없으면 비어 있습니다.
+	Extends           string // extends 뒤의 부모 타입
+	Description       string
+	Deprecated        bool
+	DeprecatedMessage string
+	Examples          []Example
+	See               []SeeRef
+	Since             string            // 스크립트가 처음 추가된 버전
+	Version           string            // ---@version으로 적은 스크립트의 현재 버전
+	Metadata          map[string]string // 파서가 모르는 태그의 이름과 내용. 같은 태그가 여러 번 나오면 줄바꿈으로 잇습니다.
+	Attributes        []Attribute       // 스크립트에 붙은 모든 어트리뷰트 (@Logic 등 종류 어트리뷰트 포함)
+	Constants         bool              // @Enum 또는 상수 프로퍼티만 있는 스크립트. 프로퍼티를 값/의미 테이블로 표시합니다.
+	Properties        []PropertyDoc
+	Methods           []MethodDoc
+	Handlers          []HandlerDoc
+	Span, DocSpan     Span // 스크립트 선언과 그 문서 주석의 위치
 }
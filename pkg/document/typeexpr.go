@@ -0,0 +1,221 @@
+package document
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 이 파일은 시그니처와 `---@param`에 쓰이는 타입 표현식을 해석합니다.
+//
+//	union   = postfix { "|" postfix }
+//	postfix = primary { "[]" | "?" }
+//	primary = Name [ "<" union { "," union } ">" ] | "(" union ")"
+//	Name    = ident { "." ident }
+
+// TypeKind는 타입 표현식 노드의 종류입니다.
+type TypeKind int
+
+const (
+	TypeNamed    TypeKind = iota // number, table<string, Vector3>
+	TypeUnion                    // A | B
+	TypeArray                    // T[]
+	TypeNullable                 // T?
+)
+
+// TypeExpr는 타입 표현식의 구문 트리입니다.
+type TypeExpr struct {
+	Kind TypeKind
+	Name string      // TypeNamed의 타입 이름
+	Args []*TypeExpr // TypeNamed의 제네릭 인자, TypeUnion의 항목
+	Elem *TypeExpr   // TypeArray, TypeNullable의 대상 타입
+}
+
+// ParseTypeExpr는 `table<string, Vector3>[] | nil` 같은 타입 표현식을 해석합니다.
+func ParseTypeExpr(s string) (*TypeExpr, error) {
+	p := &typeParser{src: s}
+	t, err := p.union()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.i < len(p.src) {
+		return nil, fmt.Errorf("타입 %q의 %d번째 문자 %q를 해석할 수 없습니다", s, p.i+1, p.src[p.i])
+	}
+	return t, nil
+}
+
+// Names는 표현식에 등장하는 타입 이름을 등장 순서대로, 중복 없이 반환합니다.
+func (t *TypeExpr) Names() []string {
+	var names []string
+	seen := make(map[string]bool)
+	var walk func(*TypeExpr)
+	walk = func(t *TypeExpr) {
+		if t.Kind == TypeNamed && !seen[t.Name] {
+			seen[t.Name] = true
+			names = append(names, t.Name)
+		}
+		for _, a := range t.Args {
+			walk(a)
+		}
+		if t.Elem != nil {
+			walk(t.Elem)
+		}
+	}
+	walk(t)
+	return names
+}
+
+// String은 표현식을 정규화된 형태(`A | B`, `table<K, V>`)로 반환합니다.
+func (t *TypeExpr) String() string {
+	switch t.Kind {
+	case TypeUnion:
+		return joinTypes(t.Args, " | ")
+	case TypeArray:
+		return t.Elem.operand() + "[]"
+	case TypeNullable:
+		return t.Elem.operand() + "?"
+	}
+	if len(t.Args) == 0 {
+		return t.Name
+	}
+	return t.Name + "<" + joinTypes(t.Args, ", ") + ">"
+}
+
+// operand는 접미사(`[]`, `?`)를 붙일 표현식을 반환합니다. 유니온은 괄호로 감쌉니다.
+func (t *TypeExpr) operand() string {
+	if t.Kind == TypeUnion {
+		return "(" + t.String() + ")"
+	}
+	return t.String()
+}
+
+func joinTypes(types []*TypeExpr, sep string) string {
+	items := make([]string, len(types))
+	for i, t := range types {
+		items[i] = t.String()
+	}
+	return strings.Join(items, sep)
+}
+
+type typeParser struct {
+	src string
+	i   int
+}
+
+func (p *typeParser) skipSpace() {
+	for p.i < len(p.src) && (p.src[p.i] == ' ' || p.src[p.i] == '\t') {
+		p.i++
+	}
+}
+
+func (p *typeParser) accept(s string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.src[p.i:], s) {
+		p.i += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *typeParser) union() (*TypeExpr, error) {
+	first, err := p.postfix()
+	if err != nil {
+		return nil, err
+	}
+	items := []*TypeExpr{first}
+	for p.accept("|") {
+		t, err := p.postfix()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, t)
+	}
+	if len(items) == 1 {
+		return first, nil
+	}
+	return &TypeExpr{Kind: TypeUnion, Args: items}, nil
+}
+
+func (p *typeParser) postfix() (*TypeExpr, error) {
+	t, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("[]"):
+			t = &TypeExpr{Kind: TypeArray, Elem: t}
+		case p.accept("?"):
+			t = &TypeExpr{Kind: TypeNullable, Elem: t}
+		default:
+			return t, nil
+		}
+	}
+}
+
+func (p *typeParser) primary() (*TypeExpr, error) {
+	if p.accept("(") {
+		t, err := p.union()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("타입 %q의 괄호가 닫히지 않았습니다", p.src)
+		}
+		return t, nil
+	}
+
+	p.skipSpace()
+	start := p.i
+	for p.i < len(p.src) && (isIdentPart(p.src[p.i]) || p.src[p.i] == '.') {
+		p.i++
+	}
+	if p.i == start || !isIdentStart(p.src[start]) {
+		return nil, fmt.Errorf("타입 %q의 %d번째 위치에 타입 이름이 필요합니다", p.src, start+1)
+	}
+	t := &TypeExpr{Kind: TypeNamed, Name: p.src[start:p.i]}
+
+	if p.accept("<") {
+		for {
+			arg, err := p.union()
+			if err != nil {
+				return nil, err
+			}
+			t.Args = append(t.Args, arg)
+			if p.accept(">") {
+				break
+			}
+			if !p.accept(",") {
+				return nil, fmt.Errorf("타입 %q의 제네릭 인자가 닫히지 않았습니다", p.src)
+			}
+		}
+	}
+	return t, nil
+}
+
+// cutTypeExpr는 `---@param 이름 타입 설명`에서 이름 뒤의 타입 표현식을 잘라 냅니다.
+// 괄호 안의 공백과 `A | B`처럼 유니온 연산자 주변의 공백은 타입에 포함합니다.
+func cutTypeExpr(s string) (typ, rest string) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '<' || c == '(' || c == '[':
+			depth++
+		case c == '>' || c == ')' || c == ']':
+			depth--
+		case depth > 0:
+		case c == ' ' || c == '\t':
+			j := i
+			for j < len(s) && (s[j] == ' ' || s[j] == '\t') {
+				j++
+			}
+			if (j < len(s) && s[j] == '|') || (i > 0 && s[i-1] == '|') {
+				i = j - 1
+				continue
+			}
+			return s[:i], strings.TrimSpace(s[i:])
+		case c == '"' || c == '=':
+			return strings.TrimSpace(s[:i]), s[i:]
+		}
+	}
+	return s, ""
+}
@@ -0,0 +1,95 @@
+package document
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTypeExpr(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		names []string
+	}{
+		{"number", "number", []string{"number"}},
+		{"table<string, Vector3>", "table<string, Vector3>", []string{"table", "string", "Vector3"}},
+		{"table<string,table<string, number>>", "table<string, table<string, number>>", []string{"table", "string", "number"}},
+		{"DamageInfo[]", "DamageInfo[]", []string{"DamageInfo"}},
+		{"any?", "any?", []string{"any"}},
+		{"string|number | nil", "string | number | nil", []string{"string", "number", "nil"}},
+		{"(Item | Weapon)[]", "(Item | Weapon)[]", []string{"Item", "Weapon"}},
+		{"Enum.ItemGrade?", "Enum.ItemGrade?", []string{"Enum.ItemGrade"}},
+	}
+
+	for _, tt := range tests {
+		expr, err := ParseTypeExpr(tt.input)
+		if err != nil {
+			t.Errorf("ParseTypeExpr(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got := expr.String(); got != tt.want {
+			t.Errorf("ParseTypeExpr(%q).String() = %q, want %q", tt.input, got, tt.want)
+		}
+		if got := expr.Names(); !reflect.DeepEqual(got, tt.names) {
+			t.Errorf("ParseTypeExpr(%q).Names() = %v, want %v", tt.input, got, tt.names)
+		}
+	}
+
+	for _, bad := range []string{"", "table<string", "A |", "(A | B", "1abc", "A B"} {
+		if _, err := ParseTypeExpr(bad); err == nil {
+			t.Errorf("ParseTypeExpr(%q) should fail", bad)
+		}
+	}
+}
+
+func TestParseGenericParamTag(t *testing.T) {
+	input := `@Logic
+script Inventory extends Logic
+	---@param items table<string, Vector3> "Item positions"
+	---@param owner Player | nil Current owner
+	---@param tags string[]
+	method void SetItems(table<string, Vector3> items, Player | nil owner, string[] tags)
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+	if len(diags) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diags)
+	}
+
+	want := []ParamInfo{
+		{Name: "items", Type: "table<string, Vector3>", Description: "Item positions"},
+		{Name: "owner", Type: "Player | nil", Description: "Current owner"},
+		{Name: "tags", Type: "string[]"},
+	}
+	if !reflect.DeepEqual(doc.Methods[0].Params, want) {
+		t.Errorf("Params = %+v, want %+v", doc.Methods[0].Params, want)
+	}
+}
+
+func TestParseGenericReturnTag(t *testing.T) {
+	input := `@Logic
+script Shop extends Logic
+	---@return table<string, number> "Prices"
+	method table<string,number> GetPrices()
+	end
+
+	---@return "Missing type"
+	method number Count()
+	end
+end`
+
+	doc, diags, err := ParseWithOptions(input, Options{})
+	if err != nil {
+		t.Fatalf("ParseWithOptions() error = %v", err)
+	}
+	if doc.Methods[0].ReturnDescription != "Prices" {
+		t.Errorf("ReturnDescription = %q, want Prices", doc.Methods[0].ReturnDescription)
+	}
+	if len(diags) != 1 || diags[0].Code != CodeMalformedReturn || diags[0].Pos.Line != 7 {
+		t.Errorf("diags = %v, want a single malformed-return at line 7", diags)
+	}
+}
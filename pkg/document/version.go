@@ -0,0 +1,75 @@
+package document
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareVersions는 `1.4.0` 같은 점 구분 버전을 비교하여 a가 작으면 -1, 같으면 0, 크면 1을 반환합니다.
+// 앞의 `v`는 무시하고, 빠진 자리는 0으로 봅니다. 숫자가 아닌 자리는 문자열로 비교합니다.
+func CompareVersions(a, b string) int {
+	as := strings.Split(strings.TrimPrefix(strings.TrimSpace(a), "v"), ".")
+	bs := strings.Split(strings.TrimPrefix(strings.TrimSpace(b), "v"), ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		x, y := "0", "0"
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+		if c := compareVersionPart(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareVersionPart(x, y string) int {
+	xn, xerr := strconv.Atoi(x)
+	yn, yerr := strconv.Atoi(y)
+	if xerr == nil && yerr == nil {
+		switch {
+		case xn < yn:
+			return -1
+		case xn > yn:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(x, y)
+}
+
+// FilterSince는 version 이후(같은 버전 포함)에 추가된 API만 남긴 문서를 반환합니다.
+// 스크립트 자체가 그 버전 이후에 추가되었으면 모든 멤버를 남기고, 그렇지 않으면 @since가 version 이후인 멤버만 남깁니다.
+// 남는 API가 없으면 nil을 반환합니다.
+func FilterSince(doc *Documentation, version string) *Documentation {
+	added := func(since string) bool {
+		return since != "" && CompareVersions(since, version) >= 0
+	}
+	if added(doc.Since) {
+		return doc
+	}
+
+	filtered := *doc
+	filtered.Properties, filtered.Methods, filtered.Handlers = nil, nil, nil
+	for _, p := range doc.Properties {
+		if added(p.Since) {
+			filtered.Properties = append(filtered.Properties, p)
+		}
+	}
+	for _, m := range doc.Methods {
+		if added(m.Since) {
+			filtered.Methods = append(filtered.Methods, m)
+		}
+	}
+	for _, h := range doc.Handlers {
+		if added(h.Since) {
+			filtered.Handlers = append(filtered.Handlers, h)
+		}
+	}
+	if len(filtered.Properties)+len(filtered.Methods)+len(filtered.Handlers) == 0 {
+		return nil
+	}
+	return &filtered
+}
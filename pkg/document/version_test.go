@@ -0,0 +1,57 @@
+package document
+
+import "testing"
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.4.0", "1.4.0", 0},
+		{"1.4", "1.4.0", 0},
+		{"v1.10.0", "1.9.3", 1},
+		{"1.3.9", "1.4.0", -1},
+		{"2.0.0-beta", "2.0.0-alpha", 1},
+	}
+
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFilterSince(t *testing.T) {
+	doc := &Documentation{
+		Name:       "Shop",
+		Since:      "1.0.0",
+		Properties: []PropertyDoc{{Name: "discount", Since: "1.4.0"}, {Name: "stock"}},
+		Methods:    []MethodDoc{{Name: "Buy", Since: "1.3.1"}, {Name: "Refund", Since: "1.5"}},
+		Handlers:   []HandlerDoc{{Name: "OnSale", Since: "1.2.0"}},
+	}
+
+	filtered := FilterSince(doc, "1.4.0")
+	if filtered == nil {
+		t.Fatal("FilterSince() = nil, want members added since 1.4.0")
+	}
+	if len(filtered.Properties) != 1 || filtered.Properties[0].Name != "discount" {
+		t.Errorf("Properties = %+v, want only discount", filtered.Properties)
+	}
+	if len(filtered.Methods) != 1 || filtered.Methods[0].Name != "Refund" {
+		t.Errorf("Methods = %+v, want only Refund", filtered.Methods)
+	}
+	if len(filtered.Handlers) != 0 {
+		t.Errorf("Handlers = %+v, want none", filtered.Handlers)
+	}
+	if len(doc.Methods) != 2 {
+		t.Error("FilterSince() modified the original document")
+	}
+
+	if FilterSince(doc, "2.0.0") != nil {
+		t.Error("FilterSince(2.0.0) should return nil when nothing was added")
+	}
+	doc.Since = "2.0.0"
+	if FilterSince(doc, "2.0.0") != doc {
+		t.Error("FilterSince() should keep every member of a script added in that version")
+	}
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"fmt"
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+)
+
+// memberAnchor는 멤버의 페이지 안 앵커 ID입니다. `method-SendMessageToServer`처럼 멤버 종류와 이름으로만 만들어
+// 문서 내용이 바뀌어도 같은 멤버는 같은 주소를 가집니다.
+func memberAnchor(kind, name string) string {
+	return kind + "-" + name
+}
+
+// anchorHTML은 id로 이동할 수 있는 빈 앵커 태그를 만듭니다.
+func anchorHTML(id string) string {
+	return fmt.Sprintf(`<a id="%s"></a>`, id)
+}
+
+// renderTOC는 페이지 위쪽에 둘 멤버 목차를 만듭니다. 각 항목은 본문의 멤버 앵커로 연결되며,
+// 메서드는 본문과 같은 섹션(Methods, Static Methods, Lifecycle)으로 나눕니다.
+func renderTOC(doc *document.Documentation) string {
+	var lines []string
+	add := func(label, kind string, names []string) {
+		if len(names) == 0 {
+			return
+		}
+		links := make([]string, len(names))
+		for i, name := range names {
+			links[i] = fmt.Sprintf("[%s](#%s)", name, memberAnchor(kind, name))
+		}
+		lines = append(lines, fmt.Sprintf("- **%s**: %s\n", label, strings.Join(links, ", ")))
+	}
+
+	var props []string
+	for _, p := range doc.Properties {
+		props = append(props, p.Name)
+	}
+	if doc.Constants {
+		add("Values", "property", props)
+	} else {
+		add("Properties", "property", props)
+	}
+
+	var methods, statics, lifecycle []string
+	for _, m := range doc.Methods {
+		switch {
+		case m.Lifecycle:
+			lifecycle = append(lifecycle, m.Name)
+		case m.Static:
+			statics = append(statics, m.Name)
+		default:
+			methods = append(methods, m.Name)
+		}
+	}
+	add("Methods", "method", methods)
+	add("Static Methods", "method", statics)
+	add("Lifecycle", "method", lifecycle)
+
+	var handlers []string
+	for _, h := range doc.Handlers {
+		handlers = append(handlers, h.Name)
+	}
+	add("Handlers", "handler", handlers)
+
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "") + "\n"
+}
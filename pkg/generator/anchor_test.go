@@ -0,0 +1,67 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+	"testing"
+)
+
+func TestGenerateMemberAnchorsAndTOC(t *testing.T) {
+	event := &document.Documentation{
+		DocType:  "Event",
+		Name:     "DamageEvent",
+		Handlers: []document.HandlerDoc{{Name: "OnRaised"}},
+	}
+	doc := &document.Documentation{
+		DocType:    "Logic",
+		Name:       "GameLogic",
+		Properties: []document.PropertyDoc{{Name: "speed", Type: "number"}},
+		Methods: []document.MethodDoc{
+			{Name: "SendMessageToServer", ReturnType: "void", See: []document.SeeRef{{Target: "DamageEvent.OnRaised"}}},
+			{Name: "Create", ReturnType: "GameLogic", Static: true},
+			{Name: "OnBeginPlay", ReturnType: "void", Lifecycle: true},
+		},
+		Handlers: []document.HandlerDoc{{Name: "OnPlayerConnect"}},
+	}
+	project := NewProject()
+	project.Add("DamageEvent", event, "event/DamageEvent.md")
+	project.Add("GameLogic", doc, "logic/GameLogic.md")
+
+	md, err := Generate(doc, Page{Path: "logic/GameLogic.md", Project: project})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	toc := "- **Properties**: [speed](#property-speed)\n" +
+		"- **Methods**: [SendMessageToServer](#method-SendMessageToServer)\n" +
+		"- **Static Methods**: [Create](#method-Create)\n" +
+		"- **Lifecycle**: [OnBeginPlay](#method-OnBeginPlay)\n" +
+		"- **Handlers**: [OnPlayerConnect](#handler-OnPlayerConnect)\n"
+	if !strings.Contains(md, toc) || strings.Index(md, toc) > strings.Index(md, "## Properties") {
+		t.Errorf("Expected TOC before the member sections:\n%s", md)
+	}
+
+	for _, id := range []string{"property-speed", "method-SendMessageToServer", "method-Create", "method-OnBeginPlay", "handler-OnPlayerConnect"} {
+		if strings.Count(md, anchorHTML(id)) != 1 {
+			t.Errorf("Expected exactly one anchor %q in output:\n%s", id, md)
+		}
+	}
+
+	if want := "[DamageEvent.OnRaised](../event/DamageEvent.md#handler-OnRaised)"; !strings.Contains(md, want) {
+		t.Errorf("Expected %q not found in output:\n%s", want, md)
+	}
+}
+
+func TestRenderTOCConstants(t *testing.T) {
+	doc := &document.Documentation{
+		DocType:    "Enum",
+		Constants:  true,
+		Properties: []document.PropertyDoc{{Name: "Common"}, {Name: "Rare"}},
+	}
+	if got, want := renderTOC(doc), "- **Values**: [Common](#property-Common), [Rare](#property-Rare)\n\n"; got != want {
+		t.Errorf("renderTOC() = %q, want %q", got, want)
+	}
+	if got := renderTOC(&document.Documentation{}); got != "" {
+		t.Errorf("renderTOC() of an empty document = %q, want empty", got)
+	}
+}
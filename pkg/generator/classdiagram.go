@@ -0,0 +1,166 @@
+package generator
+
+import (
+	"fmt"
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+)
+
+// classRelations는 클래스 다이어그램에 그릴 상속 관계와 Struct 사용 관계입니다.
+type classRelations struct {
+	parents [][2]string // 자식, 부모
+	uses    [][2]string // 사용하는 스크립트, Struct
+}
+
+// relationsOf는 scripts의 부모 타입과, 프로퍼티·파라미터 타입에 쓰인 Struct를 등장 순서대로 중복 없이 모읍니다.
+// Struct인지는 project에 등록된 문서로 판단합니다.
+func relationsOf(scripts []*ProjectScript, project *Project) classRelations {
+	var rel classRelations
+	for _, s := range scripts {
+		if s.Doc.Extends != "" {
+			rel.parents = append(rel.parents, [2]string{s.Name, s.Doc.Extends})
+		}
+
+		seen := make(map[string]bool)
+		addUses := func(typeName string) {
+			expr, err := document.ParseTypeExpr(typeName)
+			if err != nil {
+				return
+			}
+			for _, name := range expr.Names() {
+				target, ok := project.Lookup(name)
+				if !ok || target.Doc.DocType != "Struct" || name == s.Name || seen[name] {
+					continue
+				}
+				seen[name] = true
+				rel.uses = append(rel.uses, [2]string{s.Name, name})
+			}
+		}
+		for _, p := range s.Doc.Properties {
+			addUses(p.Type)
+		}
+		for _, m := range s.Doc.Methods {
+			for _, p := range m.Params {
+				addUses(p.Type)
+			}
+		}
+		for _, h := range s.Doc.Handlers {
+			for _, p := range h.Params {
+				addUses(p.Type)
+			}
+		}
+	}
+	return rel
+}
+
+// GenerateClassDiagram은 scripts의 Mermaid classDiagram을 만듭니다. 코드 블록 펜스는 포함하지 않습니다.
+// 스크립트마다 종류, 프로퍼티, 메서드를 표시하고, 부모 타입은 상속으로, Struct 사용은 연관으로 잇습니다.
+func GenerateClassDiagram(scripts []*ProjectScript, project *Project) string {
+	var b strings.Builder
+	b.WriteString("classDiagram\n")
+	for _, s := range scripts {
+		b.WriteString(fmt.Sprintf("    class %s {\n", s.Name))
+		if s.Doc.DocType != "" {
+			b.WriteString(fmt.Sprintf("        <<%s>>\n", s.Doc.DocType))
+		}
+		for _, p := range s.Doc.Properties {
+			b.WriteString(fmt.Sprintf("        +%s %s\n", mermaidType(p.Type), p.Name))
+		}
+		for _, m := range s.Doc.Methods {
+			b.WriteString("        " + mermaidMethod(m) + "\n")
+		}
+		b.WriteString("    }\n")
+	}
+
+	rel := relationsOf(scripts, project)
+	for _, r := range rel.parents {
+		b.WriteString(fmt.Sprintf("    %s <|-- %s\n", r[1], r[0]))
+	}
+	for _, r := range rel.uses {
+		b.WriteString(fmt.Sprintf("    %s --> %s : uses\n", r[0], r[1]))
+	}
+	return b.String()
+}
+
+// mermaidMethod는 메서드를 `+Move(Vector3 direction) void` 형식의 클래스 멤버로 만듭니다.
+// local 함수는 `-`, static 메서드는 `$` 분류자를 붙입니다.
+func mermaidMethod(m document.MethodDoc) string {
+	visibility := "+"
+	if m.Local {
+		visibility = "-"
+	}
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = strings.TrimSpace(mermaidType(p.Type) + " " + p.Name)
+	}
+	line := fmt.Sprintf("%s%s(%s)", visibility, m.Name, strings.Join(params, ", "))
+	if m.ReturnType != "" {
+		line += " " + mermaidType(m.ReturnType)
+	}
+	if m.Static {
+		line += "$"
+	}
+	return line
+}
+
+// mermaidType은 Mermaid가 `~`로 표기하는 제네릭 괄호를 바꿉니다.
+func mermaidType(typeName string) string {
+	return strings.NewReplacer("<", "~", ">", "~").Replace(typeName)
+}
+
+// GenerateClassDiagramDOT는 GenerateClassDiagram과 같은 내용을 Graphviz DOT 그래프로 만듭니다.
+func GenerateClassDiagramDOT(scripts []*ProjectScript, project *Project) string {
+	var b strings.Builder
+	b.WriteString("digraph Classes {\n")
+	b.WriteString("    rankdir=BT;\n")
+	b.WriteString("    node [shape=record];\n")
+	for _, s := range scripts {
+		title := s.Name
+		if s.Doc.DocType != "" {
+			title = "«" + s.Doc.DocType + "»\\n" + s.Name
+		}
+		var props, methods strings.Builder
+		for _, p := range s.Doc.Properties {
+			props.WriteString(dotRecordText("+ "+p.Name+" : "+p.Type) + `\l`)
+		}
+		for _, m := range s.Doc.Methods {
+			methods.WriteString(dotRecordText(dotMethod(m)) + `\l`)
+		}
+		b.WriteString(fmt.Sprintf("    %s [label=\"{%s|%s|%s}\"];\n", dotQuote(s.Name), title, props.String(), methods.String()))
+	}
+
+	rel := relationsOf(scripts, project)
+	for _, r := range rel.parents {
+		b.WriteString(fmt.Sprintf("    %s -> %s [arrowhead=empty];\n", dotQuote(r[0]), dotQuote(r[1])))
+	}
+	for _, r := range rel.uses {
+		b.WriteString(fmt.Sprintf("    %s -> %s [arrowhead=vee, style=dashed, label=\"uses\"];\n", dotQuote(r[0]), dotQuote(r[1])))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotMethod는 메서드를 DOT 레코드에 쓸 `+ Move(Vector3 direction) : void` 형식으로 만듭니다.
+func dotMethod(m document.MethodDoc) string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = strings.TrimSpace(p.Type + " " + p.Name)
+	}
+	visibility := "+ "
+	if m.Local {
+		visibility = "- "
+	}
+	line := visibility + m.Name + "(" + strings.Join(params, ", ") + ")"
+	if m.ReturnType != "" {
+		line += " : " + m.ReturnType
+	}
+	if m.Static {
+		line += " «static»"
+	}
+	return line
+}
+
+// dotRecordText는 DOT 레코드 레이블에서 특별한 의미를 갖는 문자를 이스케이프합니다.
+func dotRecordText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "{", `\{`, "}", `\}`, "|", `\|`, "<", `\<`, ">", `\>`).Replace(s)
+}
@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+	"testing"
+)
+
+func classProject() *Project {
+	project := NewProject()
+	project.Add("Combat", &document.Documentation{
+		DocType:    "Logic",
+		Name:       "Combat",
+		Extends:    "Logic",
+		Properties: []document.PropertyDoc{{Name: "hits", Type: "table<string, DamageInfo>"}},
+		Methods: []document.MethodDoc{
+			{Name: "Attack", ReturnType: "void", Static: true, Params: []document.ParamInfo{{Name: "info", Type: "DamageInfo"}}},
+			{Name: "clamp", ReturnType: "number", Local: true, Params: []document.ParamInfo{{Name: "v", Type: "number"}}},
+		},
+	}, "logic/Combat.md")
+	project.Add("DamageInfo", &document.Documentation{
+		DocType:    "Struct",
+		Name:       "DamageInfo",
+		Properties: []document.PropertyDoc{{Name: "amount", Type: "number"}},
+	}, "struct/DamageInfo.md")
+	project.Add("Mover", &document.Documentation{
+		DocType:    "Component",
+		Name:       "Mover",
+		Extends:    "Component",
+		Properties: []document.PropertyDoc{{Name: "combat", Type: "Combat"}},
+	}, "component/Mover.md")
+	return project
+}
+
+func TestGenerateClassDiagram(t *testing.T) {
+	project := classProject()
+	diagram := GenerateClassDiagram(project.Scripts(), project)
+	expected := []string{
+		"classDiagram\n",
+		"    class Combat {\n        <<Logic>>\n        +table~string, DamageInfo~ hits\n",
+		"        +Attack(DamageInfo info) void$\n",
+		"        -clamp(number v) number\n",
+		"    Logic <|-- Combat\n",
+		"    Component <|-- Mover\n",
+		"    Combat --> DamageInfo : uses\n",
+	}
+	for _, e := range expected {
+		if !strings.Contains(diagram, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, diagram)
+		}
+	}
+	// Struct가 아닌 타입은 연관으로 잇지 않습니다.
+	if strings.Contains(diagram, "Mover --> Combat") {
+		t.Errorf("Only Struct usage should become an association:\n%s", diagram)
+	}
+	if strings.Count(diagram, "Combat --> DamageInfo") != 1 {
+		t.Errorf("Associations should not repeat:\n%s", diagram)
+	}
+}
+
+func TestGenerateClassDiagramDOT(t *testing.T) {
+	project := classProject()
+	dot := GenerateClassDiagramDOT(project.ScriptsIn("logic"), project)
+	expected := []string{
+		`"Combat" [label="{«Logic»\nCombat|+ hits : table\<string, DamageInfo\>\l|+ Attack(DamageInfo info) : void «static»\l- clamp(number v) : number\l}"];`,
+		`"Combat" -> "Logic" [arrowhead=empty];`,
+		`"Combat" -> "DamageInfo" [arrowhead=vee, style=dashed, label="uses"];`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(dot, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, dot)
+		}
+	}
+	if strings.Contains(dot, `"Mover" [`) {
+		t.Errorf("Scripts outside the folder should not be drawn:\n%s", dot)
+	}
+}
+
+func TestGenerateIndex(t *testing.T) {
+	project := classProject()
+
+	if got, want := project.Folders(), []string{"component", "logic", "struct"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Folders() = %v, want %v", got, want)
+	}
+	if got := IndexPath("logic"); got != "logic/index.md" {
+		t.Errorf("IndexPath(logic) = %q", got)
+	}
+
+	root := GenerateIndex(project, ".")
+	if !strings.HasPrefix(root, "# API Reference\n\n```mermaid\nclassDiagram\n") || !strings.Contains(root, "class Mover {") {
+		t.Errorf("Root index should start with the project diagram:\n%s", root)
+	}
+
+	logic := GenerateIndex(project, "logic")
+	if !strings.HasPrefix(logic, "# Logic\n\n```mermaid\n") || strings.Contains(logic, "class Mover {") {
+		t.Errorf("Folder index should only draw its own scripts:\n%s", logic)
+	}
+}
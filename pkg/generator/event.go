@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"fmt"
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+)
+
+// Subscriber는 이벤트를 구독하는 핸들러와 그 핸들러가 정의된 스크립트입니다.
+type Subscriber struct {
+	Script  *ProjectScript
+	Handler document.HandlerDoc
+}
+
+// IsEvent는 name이 프로젝트에 문서가 있는 @Event 스크립트인지 확인합니다.
+// 핸들러의 EventType은 시그니처만 보고 정한 것이므로, 실제 이벤트인지는 이것으로 가립니다.
+func (p *Project) IsEvent(name string) bool {
+	s, ok := p.Lookup(name)
+	return ok && s.Doc.DocType == "Event"
+}
+
+// Subscribers는 프로젝트 전체에서 event 타입을 파라미터로 받는 핸들러를 스크립트 이름 순으로 반환합니다.
+// 페이지가 만들어지는 문서의 핸들러만 포함합니다.
+func (p *Project) Subscribers(event string) []Subscriber {
+	if !p.IsEvent(event) {
+		return nil
+	}
+	var subs []Subscriber
+	for _, s := range p.Pages() {
+		for _, h := range s.Doc.Handlers {
+			if h.EventType == event {
+				subs = append(subs, Subscriber{Script: s, Handler: h})
+			}
+		}
+	}
+	return subs
+}
+
+// renderSubscribers는 Event 페이지에 붙일 구독 핸들러 목록을 만듭니다. pagePath는 이 Event 페이지의 경로입니다.
+func renderSubscribers(subs []Subscriber, pagePath string) string {
+	if len(subs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n## Subscribers\n\n")
+	b.WriteString("| Script | Handler | Description |\n")
+	b.WriteString("| --- | --- | --- |\n")
+	for _, sub := range subs {
+		link := relativeLink(pagePath, sub.Script.Path)
+		b.WriteString(fmt.Sprintf("| [%s](%s) | [`%s`](%s#%s) | %s |\n",
+			sub.Script.Name, link, sub.Handler.Name, link, memberAnchor("handler", sub.Handler.Name), tableCell(sub.Handler.Description)))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+	"testing"
+)
+
+func TestProjectSubscribers(t *testing.T) {
+	damage := &document.Documentation{DocType: "Event", Name: "DamageEvent"}
+	combat := &document.Documentation{
+		DocType: "Logic",
+		Name:    "Combat",
+		Handlers: []document.HandlerDoc{
+			{Name: "HandleDamage", EventType: "DamageEvent", EventVar: "event", Description: "Applies | damage"},
+			{Name: "HandleHeal", EventType: "HealEvent", EventVar: "event"},
+			{Name: "HandleEntity", EventType: "Entity", EventVar: "e"},
+		},
+	}
+	hud := &document.Documentation{
+		DocType:  "Component",
+		Name:     "Hud",
+		Handlers: []document.HandlerDoc{{Name: "ShowDamage", EventType: "DamageEvent", EventVar: "e"}},
+	}
+	project := NewProject()
+	project.Add("DamageEvent", damage, "event/DamageEvent.md")
+	project.Add("Combat", combat, "logic/Combat.md")
+	project.Add("Hud", hud, "component/Hud.md")
+
+	subs := project.Subscribers("DamageEvent")
+	if len(subs) != 2 || subs[0].Handler.Name != "HandleDamage" || subs[1].Handler.Name != "ShowDamage" {
+		t.Fatalf("Subscribers = %+v, want HandleDamage and ShowDamage", subs)
+	}
+
+	md, err := Generate(damage, Page{Path: "event/DamageEvent.md", Project: project})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	expected := []string{
+		"## Subscribers",
+		"| [Combat](../logic/Combat.md) | [`HandleDamage`](../logic/Combat.md#handler-HandleDamage) | Applies \\| damage |",
+		"| [Hud](../component/Hud.md) | [`ShowDamage`](../component/Hud.md#handler-ShowDamage) |  |",
+	}
+	for _, e := range expected {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, md)
+		}
+	}
+
+	md, err = Generate(combat, Page{Path: "logic/Combat.md", TypeLinks: project.TypeLinks("logic/Combat.md"), Project: project})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if want := "<strong>Event:</strong> " + typeLinkHTML("DamageEvent", "../event/DamageEvent.md"); !strings.Contains(md, want) {
+		t.Errorf("Expected %q not found in output:\n%s", want, md)
+	}
+	if strings.Contains(md, "<strong>Event:</strong> <span") {
+		t.Errorf("Types without an @Event page should not get an Event row:\n%s", md)
+	}
+	if subs := project.Subscribers("Entity"); len(subs) != 0 {
+		t.Errorf("Subscribers(Entity) = %+v, want none", subs)
+	}
+	if strings.Contains(md, "## Subscribers") {
+		t.Error("Subscribers should only be listed on Event pages")
+	}
+}
@@ -0,0 +1,164 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EventFlow는 한 핸들러가 어떤 발신자의 어떤 이벤트를 받는지 나타내는 이벤트 흐름 그래프의 간선입니다.
+type EventFlow struct {
+	Sender  string // `Logic AuthLogic`, `LocalPlayer` 등 EventSender 어트리뷰트. 없으면 비어 있습니다.
+	Event   string
+	Script  string
+	Handler string
+}
+
+// EventFlows는 프로젝트의 모든 핸들러에서 이벤트 흐름을 모아 스크립트 이름 순으로 반환합니다.
+// KeyDownEvent 같은 엔진 이벤트도 포함하며, 구독하는 이벤트 타입이 없는 핸들러만 제외합니다.
+// 페이지가 만들어지는 문서의 핸들러만 포함합니다.
+func (p *Project) EventFlows() []EventFlow {
+	var flows []EventFlow
+	for _, s := range p.Pages() {
+		for _, h := range s.Doc.Handlers {
+			if h.EventType == "" {
+				continue
+			}
+			sender := h.EventSenderType
+			if h.EventSenderValue != "" && (sender == "Logic" || sender == "Service") {
+				sender += " " + h.EventSenderValue
+			}
+			flows = append(flows, EventFlow{Sender: sender, Event: h.EventType, Script: s.Name, Handler: h.Name})
+		}
+	}
+	return flows
+}
+
+// label은 이벤트에서 스크립트로 가는 간선의 레이블입니다. 같은 이벤트를 여러 발신자에게서 받을 수 있으므로
+// 발신자가 있으면 핸들러 이름 뒤에 함께 적습니다.
+func (f EventFlow) label() string {
+	if f.Sender == "" {
+		return f.Handler
+	}
+	return f.Handler + " (" + f.Sender + ")"
+}
+
+// flowGraph는 이벤트 흐름을 발신자, 이벤트, 스크립트 노드와 중복 없는 간선으로 정리한 것입니다.
+// 노드와 간선은 처음 등장한 순서를 유지합니다.
+type flowGraph struct {
+	senders, events, scripts []string
+	senderEdges              [][2]string // 발신자 → 이벤트
+	handlerEdges             []EventFlow // 이벤트 → 스크립트
+}
+
+func newFlowGraph(flows []EventFlow) *flowGraph {
+	g := &flowGraph{}
+	seen := make(map[string]bool)
+	add := func(list *[]string, kind, name string) {
+		if !seen[kind+":"+name] {
+			seen[kind+":"+name] = true
+			*list = append(*list, name)
+		}
+	}
+	for _, f := range flows {
+		add(&g.events, "event", f.Event)
+		add(&g.scripts, "script", f.Script)
+		if f.Sender != "" {
+			add(&g.senders, "sender", f.Sender)
+			if edge := "edge:" + f.Sender + "\x00" + f.Event; !seen[edge] {
+				seen[edge] = true
+				g.senderEdges = append(g.senderEdges, [2]string{f.Sender, f.Event})
+			}
+		}
+		g.handlerEdges = append(g.handlerEdges, f)
+	}
+	return g
+}
+
+// eventPage는 event의 @Event 문서 페이지로 가는 from 기준 링크를 반환합니다. 문서가 없는 엔진 이벤트이면 false입니다.
+func (p *Project) eventPage(from, event string) (string, bool) {
+	s, ok := p.Lookup(event)
+	if !ok || s.Doc.DocType != "Event" || !s.HasPage() {
+		return "", false
+	}
+	return relativeLink(from, s.Path), true
+}
+
+// GenerateEventFlowMermaid는 이벤트 흐름을 Mermaid flowchart가 담긴 Markdown 페이지로 만듭니다.
+// pagePath는 출력 루트 기준 이 페이지의 경로이며, 문서가 있는 이벤트 노드를 그 문서로 연결하는 데 쓰입니다.
+func GenerateEventFlowMermaid(project *Project, pagePath string) string {
+	flows := project.EventFlows()
+	var b strings.Builder
+	b.WriteString("# Event Flow\n\n")
+	if len(flows) == 0 {
+		b.WriteString("이벤트를 구독하는 핸들러가 없습니다.\n")
+		return b.String()
+	}
+
+	g := newFlowGraph(flows)
+	ids := make(map[string]string)
+	b.WriteString("```mermaid\nflowchart LR\n")
+	for i, s := range g.senders {
+		ids["sender:"+s] = fmt.Sprintf("s%d", i)
+		b.WriteString(fmt.Sprintf("    s%d[/\"%s\"/]\n", i, mermaidLabel(s)))
+	}
+	for i, e := range g.events {
+		ids["event:"+e] = fmt.Sprintf("e%d", i)
+		b.WriteString(fmt.Sprintf("    e%d([\"%s\"])\n", i, mermaidLabel(e)))
+		if link, ok := project.eventPage(pagePath, e); ok {
+			b.WriteString(fmt.Sprintf("    click e%d href \"%s\"\n", i, link))
+		}
+	}
+	for i, s := range g.scripts {
+		ids["script:"+s] = fmt.Sprintf("c%d", i)
+		b.WriteString(fmt.Sprintf("    c%d[\"%s\"]\n", i, mermaidLabel(s)))
+	}
+	for _, e := range g.senderEdges {
+		b.WriteString(fmt.Sprintf("    %s --> %s\n", ids["sender:"+e[0]], ids["event:"+e[1]]))
+	}
+	for _, f := range g.handlerEdges {
+		b.WriteString(fmt.Sprintf("    %s -->|\"%s\"| %s\n", ids["event:"+f.Event], mermaidLabel(f.label()), ids["script:"+f.Script]))
+	}
+	b.WriteString("```\n")
+	return b.String()
+}
+
+// GenerateEventFlowDOT는 이벤트 흐름을 Graphviz DOT 그래프로 만듭니다. 문서가 있는 이벤트 노드에는
+// pagePath 기준의 문서 링크(URL)를 붙입니다.
+func GenerateEventFlowDOT(project *Project, pagePath string) string {
+	g := newFlowGraph(project.EventFlows())
+	var b strings.Builder
+	b.WriteString("digraph EventFlow {\n")
+	b.WriteString("    rankdir=LR;\n")
+	b.WriteString("    node [shape=box];\n")
+	for _, s := range g.senders {
+		b.WriteString(fmt.Sprintf("    %s [label=%s, shape=parallelogram];\n", dotQuote("sender:"+s), dotQuote(s)))
+	}
+	for _, e := range g.events {
+		url := ""
+		if link, ok := project.eventPage(pagePath, e); ok {
+			url = ", URL=" + dotQuote(link)
+		}
+		b.WriteString(fmt.Sprintf("    %s [label=%s, shape=ellipse%s];\n", dotQuote("event:"+e), dotQuote(e), url))
+	}
+	for _, s := range g.scripts {
+		b.WriteString(fmt.Sprintf("    %s [label=%s];\n", dotQuote("script:"+s), dotQuote(s)))
+	}
+	for _, e := range g.senderEdges {
+		b.WriteString(fmt.Sprintf("    %s -> %s;\n", dotQuote("sender:"+e[0]), dotQuote("event:"+e[1])))
+	}
+	for _, f := range g.handlerEdges {
+		b.WriteString(fmt.Sprintf("    %s -> %s [label=%s];\n", dotQuote("event:"+f.Event), dotQuote("script:"+f.Script), dotQuote(f.label())))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// mermaidLabel은 Mermaid의 따옴표 레이블 안에서 쓸 수 없는 `"`를 엔티티로 바꿉니다.
+func mermaidLabel(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
+
+// dotQuote는 DOT의 따옴표 ID를 만듭니다.
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,120 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+	"testing"
+)
+
+func flowProject() *Project {
+	project := NewProject()
+	project.Add("Announcer", &document.Documentation{
+		DocType: "Logic",
+		Name:    "Announcer",
+		Handlers: []document.HandlerDoc{
+			{Name: "Announce", EventType: "DamageEvent", EventSenderType: "Logic", EventSenderValue: "Combat"},
+			{Name: "OnKey", EventType: "KeyDownEvent", EventSenderType: "LocalPlayer"},
+			{Name: "OnTick"},
+		},
+	}, "logic/Announcer.md")
+	project.Add("Hud", &document.Documentation{
+		DocType:  "Component",
+		Name:     "Hud",
+		Handlers: []document.HandlerDoc{{Name: "ShowDamage", EventType: "DamageEvent"}},
+	}, "component/Hud.md")
+	project.Add("DamageEvent", &document.Documentation{DocType: "Event", Name: "DamageEvent"}, "event/DamageEvent.md")
+	return project
+}
+
+func TestProjectEventFlows(t *testing.T) {
+	flows := flowProject().EventFlows()
+	want := []EventFlow{
+		{Sender: "Logic Combat", Event: "DamageEvent", Script: "Announcer", Handler: "Announce"},
+		{Sender: "LocalPlayer", Event: "KeyDownEvent", Script: "Announcer", Handler: "OnKey"},
+		{Event: "DamageEvent", Script: "Hud", Handler: "ShowDamage"},
+	}
+	if len(flows) != len(want) {
+		t.Fatalf("EventFlows = %+v, want %+v", flows, want)
+	}
+	for i := range want {
+		if flows[i] != want[i] {
+			t.Errorf("EventFlows[%d] = %+v, want %+v", i, flows[i], want[i])
+		}
+	}
+}
+
+func TestGenerateEventFlowMermaid(t *testing.T) {
+	md := GenerateEventFlowMermaid(flowProject(), "event-flow.md")
+	expected := []string{
+		"```mermaid\nflowchart LR\n",
+		`s0[/"Logic Combat"/]`,
+		`e0(["DamageEvent"])`,
+		`c1["Hud"]`,
+		"s0 --> e0",
+		`e0 -->|"Announce (Logic Combat)"| c0`,
+		`e0 -->|"ShowDamage"| c1`,
+		`click e0 href "event/DamageEvent.md"`,
+		`e1(["KeyDownEvent"])`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, md)
+		}
+	}
+
+	// KeyDownEvent는 프로젝트에 문서가 없는 엔진 이벤트이므로 노드만 그리고 링크하지 않습니다.
+	if strings.Contains(md, "click e1") {
+		t.Errorf("Engine events without a page should not be linked:\n%s", md)
+	}
+
+	if empty := GenerateEventFlowMermaid(NewProject(), "event-flow.md"); strings.Contains(empty, "mermaid") {
+		t.Errorf("Empty project should not render a diagram:\n%s", empty)
+	}
+}
+
+func TestGenerateEventFlowDOT(t *testing.T) {
+	dot := GenerateEventFlowDOT(flowProject(), "event-flow.dot")
+	expected := []string{
+		"digraph EventFlow {\n",
+		`"sender:LocalPlayer" [label="LocalPlayer", shape=parallelogram];`,
+		`"sender:LocalPlayer" -> "event:KeyDownEvent";`,
+		`"event:KeyDownEvent" [label="KeyDownEvent", shape=ellipse];`,
+		`"event:DamageEvent" [label="DamageEvent", shape=ellipse, URL="event/DamageEvent.md"];`,
+		`"event:DamageEvent" -> "script:Hud" [label="ShowDamage"];`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(dot, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, dot)
+		}
+	}
+	if got := dotQuote(`say "hi"`); got != `"say \"hi\""` {
+		t.Errorf("dotQuote() = %s", got)
+	}
+}
+
+func TestEventFlowsIncludeEngineEvents(t *testing.T) {
+	project := NewProject()
+	project.Add("InputLogic", &document.Documentation{
+		DocType: "Logic",
+		Name:    "InputLogic",
+		Handlers: []document.HandlerDoc{
+			{Name: "HandleKeyDownEvent", EventType: "KeyDownEvent", EventSenderType: "Service", EventSenderValue: "InputService"},
+		},
+	}, "logic/InputLogic.md")
+
+	want := EventFlow{Sender: "Service InputService", Event: "KeyDownEvent", Script: "InputLogic", Handler: "HandleKeyDownEvent"}
+	if flows := project.EventFlows(); len(flows) != 1 || flows[0] != want {
+		t.Fatalf("EventFlows = %+v, want [%+v]", flows, want)
+	}
+
+	md := GenerateEventFlowMermaid(project, "event-flow.md")
+	for _, e := range []string{`s0[/"Service InputService"/]`, `e0(["KeyDownEvent"])`, "s0 --> e0", `e0 -->|"HandleKeyDownEvent (Service InputService)"| c0`} {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, md)
+		}
+	}
+	dot := GenerateEventFlowDOT(project, "event-flow.dot")
+	if want := `"event:KeyDownEvent" -> "script:InputLogic" [label="HandleKeyDownEvent (Service InputService)"];`; !strings.Contains(dot, want) {
+		t.Errorf("Expected %q not found in output:\n%s", want, dot)
+	}
+}
@@ -4,35 +4,92 @@ import (
 	"bytes"
 	"fmt"
 	"generate_api_docs_mLua/pkg/document"
+	"html"
 	"html/template"
+	"net/url"
+	"sort"
 	"strings"
 )
 
+// templateFuncs는 문서 템플릿에서 쓰는 함수입니다.
+var templateFuncs = template.FuncMap{
+	"markdown": func(s string) template.HTML { return template.HTML(renderMarkdown(s)) },
+}
+
 // TypeLinkInfo는 타입 이름과 해당 타입의 문서 파일 경로를 매핑합니다.
 type TypeLinkInfo map[string]string
 
 type FuncTmplData struct {
-	ReturnType        string
-	FunctionName      string
-	FunctionParamsStr template.HTML
-	BadgeHTML         template.HTML
-	Description       string
-	Params            []document.ParamInfo
+	ReturnType              string
+	FunctionName            string
+	FunctionNameHTML        template.HTML // 원본 선언 위치 링크와 사용 중단 표시가 적용된 이름
+	FunctionParamsStr       template.HTML
+	BadgeHTML               template.HTML
+	Description             string
+	Params                  []document.ParamInfo
+	ReturnTypeHTML          template.HTML
+	ReturnTypeSignatureHTML template.HTML // 시그니처의 반환 타입. 문서가 있는 타입 이름마다 링크합니다.
+	ReturnDescription       string
+	Deprecated              bool
+	DeprecatedMessage       string
+	Metadata                map[string]string // 알 수 없는 태그. 템플릿에서 이름 순으로 한 줄씩 표시합니다.
+	Anchor                  string            // 멤버 앵커 ID
 }
 
-func Generate(doc *document.Documentation, docTitle, sourceLink string, typeLinks TypeLinkInfo) (string, error) {
+// Page는 문서 한 페이지를 만드는 데 필요한 정보입니다.
+type Page struct {
+	Title      string       // script 헤더가 없을 때 쓸 제목 (보통 파일 이름)
+	SourceLink string       // 페이지에서 원본 .mlua 파일로 가는 링크
+	Path       string       // 출력 루트 기준 이 페이지의 경로
+	TypeLinks  TypeLinkInfo // 타입 이름과 문서 링크
+	Project    *Project     // 상속받은 멤버를 찾을 프로젝트. nil이면 상속 멤버를 표시하지 않습니다.
+}
+
+// Generate는 문서 하나를 Markdown으로 만듭니다. 제목은 스크립트 이름을 쓰고,
+// script 헤더가 없는 문서에서만 page.Title을 사용합니다.
+func Generate(doc *document.Documentation, page Page) (string, error) {
 	var mdBuilder strings.Builder
+	docTitle, sourceLink, typeLinks := page.Title, page.SourceLink, page.TypeLinks
+
+	if doc.Name != "" {
+		docTitle = doc.Name
+	}
 
 	// CSS 스타일은 GitHub에서 지원하지 않으므로 제거
 	// 문서 제목에 원본 파일 링크 추가
-	mdBuilder.WriteString(fmt.Sprintf("# [%s](%s)\n\n", docTitle, sourceLink))
+	mdBuilder.WriteString(fmt.Sprintf("# [%s](%s)\n\n", deprecatedTitle(docTitle, doc.Deprecated), sourceLink))
+
+	if doc.Deprecated {
+		mdBuilder.WriteString(fmt.Sprintf("> **Deprecated**%s\n\n", deprecatedSuffix(doc.DeprecatedMessage)))
+	}
+
+	if doc.Extends != "" {
+		mdBuilder.WriteString(fmt.Sprintf("<strong>extends</strong> %s\n\n", createLinkForType(doc.Extends, typeLinks)))
+	}
+
+	if line := versionLine(doc.Since, doc.Version); line != "" {
+		mdBuilder.WriteString(line + "\n\n")
+	}
 
 	if doc.Description != "" {
 		mdBuilder.WriteString(fmt.Sprintf("%s\n\n", doc.Description))
 	}
 
+	if meta := metadataHTML(doc.Metadata); meta != "" {
+		mdBuilder.WriteString(meta + "\n\n")
+	}
+
+	mdBuilder.WriteString(renderTOC(doc))
+	mdBuilder.WriteString(renderSeeAlso(seeLinks(doc.See, doc, page)))
+	mdBuilder.WriteString(renderExamples(doc.Examples, ""))
+
+	// 열거형/상수 테이블은 프로퍼티를 값과 의미로 보여 줍니다.
+	if doc.Constants {
+		mdBuilder.WriteString(renderValuesTable(doc, page))
+	}
+
 	// Properties 렌더링
-	if len(doc.Properties) > 0 {
+	if len(doc.Properties) > 0 && !doc.Constants {
 		mdBuilder.WriteString("## Properties\n\n")
 		// 테이블에 inline style 적용
 		mdBuilder.WriteString(`<table style="width: 100%; border-collapse: collapse; border: 1px solid #ccc; margin-bottom: 16px;">`)
@@ -42,29 +99,61 @@ func Generate(doc *document.Documentation, docTitle, sourceLink string, typeLink
 		mdBuilder.WriteString(`<th style="background-color: #f0f0f0; padding: 10px 5px; text-align: left; vertical-align: top;">Description</th>`)
 		mdBuilder.WriteString(`</tr></thead><tbody>`)
 		for _, p := range doc.Properties {
-			badge, _ := Badges[p.ExecSpace]
-			desc := p.Description
+			desc := propertyDescriptionHTML(p, doc, page)
 			if p.DefaultValue != "" {
-				desc += fmt.Sprintf(" (기본값: `%s`)", p.DefaultValue)
+				desc += fmt.Sprintf(" (기본값: <code>%s</code>)", html.EscapeString(p.DefaultValue))
 			}
 			mdBuilder.WriteString(fmt.Sprintf(
-				`<tr><td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;"><strong>%s</strong>%s</td><td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;"><code>%s</code></td><td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;">%s</td></tr>`,
-				p.Name, badge, p.Type, desc,
+				`<tr><td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;">%s<strong>%s</strong>%s</td><td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;"><code>%s</code></td><td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;">%s</td></tr>`,
+				anchorHTML(memberAnchor("property", p.Name)), memberNameHTML(deprecatedName(p.Name, p.Deprecated), sourceLineLink(sourceLink, p.Span)), propertyBadges(p), paramTypeHTML(p.Type, typeLinks), desc,
 			))
 		}
 		mdBuilder.WriteString(`</tbody></table>`)
 		mdBuilder.WriteString("\n\n")
 	}
+	// 프로퍼티 예제는 Properties와 Values 테이블 모두 아래에 둡니다.
+	for _, p := range doc.Properties {
+		mdBuilder.WriteString(renderExamples(p.Examples, p.Name))
+	}
 
-	// Methods 렌더링
-	if len(doc.Methods) > 0 {
-		mdBuilder.WriteString("## Methods\n\n")
-		for _, m := range doc.Methods {
-			html, err := renderFunctionDoc(m, typeLinks)
+	// Methods 렌더링. static 메서드와 엔진 생명주기 콜백은 별도 섹션으로 나눕니다.
+	var methods, statics, lifecycle []document.MethodDoc
+	for _, m := range doc.Methods {
+		switch {
+		case m.Lifecycle:
+			lifecycle = append(lifecycle, m)
+		case m.Static:
+			statics = append(statics, m)
+		default:
+			methods = append(methods, m)
+		}
+	}
+	sections := []struct {
+		title   string
+		methods []document.MethodDoc
+	}{
+		{"Methods", methods},
+		{"Static Methods", statics},
+		{"Lifecycle", lifecycle},
+	}
+	rendered := false
+	for _, section := range sections {
+		if len(section.methods) == 0 {
+			continue
+		}
+		if rendered {
+			mdBuilder.WriteString("\n\n")
+		}
+		rendered = true
+		mdBuilder.WriteString(fmt.Sprintf("## %s\n\n", section.title))
+		for _, m := range section.methods {
+			html, err := renderFunctionDoc(m, typeLinks, sourceLink)
 			if err != nil {
 				return "", fmt.Errorf("method %s 렌더링 오류: %w", m.Name, err)
 			}
 			mdBuilder.WriteString(html)
+			mdBuilder.WriteString(renderSeeAlso(seeLinks(m.See, doc, page)))
+			mdBuilder.WriteString(renderExamples(m.Examples, ""))
 		}
 	}
 
@@ -75,37 +164,171 @@ func Generate(doc *document.Documentation, docTitle, sourceLink string, typeLink
 		}
 		mdBuilder.WriteString("## Handlers\n\n")
 		for _, h := range doc.Handlers {
-			html := renderHandlerDoc(h, typeLinks)
+			// 문서가 있는 @Event 스크립트를 받는 핸들러만 Event 줄을 표시합니다.
+			if !page.Project.IsEvent(h.EventType) {
+				h.EventType = ""
+			}
+			html := renderHandlerDoc(h, typeLinks, sourceLink)
 			mdBuilder.WriteString(html)
+			mdBuilder.WriteString(renderSeeAlso(seeLinks(h.See, doc, page)))
+			mdBuilder.WriteString(renderExamples(h.Examples, ""))
 		}
 		mdBuilder.WriteString("\n")
 	}
 
+	// 이벤트를 구독하는 핸들러 역색인
+	if doc.DocType == "Event" {
+		mdBuilder.WriteString(renderSubscribers(page.Project.Subscribers(doc.Name), page.Path))
+	}
+
+	// 상속받은 멤버 렌더링. 더 가까운 조상이 다시 정의한 멤버는 먼 조상에서 다시 나열하지 않습니다.
+	seen := memberNames(doc)
+	for _, parent := range page.Project.Ancestors(doc) {
+		mdBuilder.WriteString(renderInherited(parent, seen, page.Path))
+		for name := range memberNames(parent.Doc) {
+			seen[name] = true
+		}
+	}
+
 	return mdBuilder.String(), nil
 }
 
-// renderFunctionDoc은 메서드 문서를 function_doc.tmpl 템플릿을 사용하여 생성합니다.
-func renderFunctionDoc(m document.MethodDoc, typeLinks TypeLinkInfo) (string, error) {
-	var paramsStrBuilder strings.Builder
-	for i, p := range m.Params {
-		paramsStrBuilder.WriteString(fmt.Sprintf("%s %s", createLinkForType(p.Type, typeLinks), p.Name))
-		if i < len(m.Params)-1 {
-			paramsStrBuilder.WriteString(", ")
+// propertyBadges는 프로퍼티 이름 뒤에 붙일 ExecSpace, 어트리뷰트, Since, Deprecated 뱃지를 만듭니다.
+func propertyBadges(p document.PropertyDoc) string {
+	badge := attributeBadge("ExecSpace", p.ExecSpace)
+	badge += attributeBadges(p.Attributes)
+	badge += sinceBadge(p.Since)
+	if p.Deprecated {
+		badge += Badges["Deprecated"]
+	}
+	return badge
+}
+
+// propertyDescriptionHTML은 프로퍼티 설명 셀의 내용을 만듭니다. 사용 중단 안내, See also, 메타데이터를 함께 넣습니다.
+func propertyDescriptionHTML(p document.PropertyDoc, doc *document.Documentation, page Page) string {
+	desc := renderMarkdown(p.Description)
+	if p.Deprecated {
+		desc = deprecationNoteHTML(p.DeprecatedMessage) + "<br>" + desc
+	}
+	if see := seeAlsoHTML(seeLinks(p.See, doc, page)); see != "" {
+		desc += "<br>" + see
+	}
+	if meta := metadataHTML(p.Metadata); meta != "" {
+		desc += "<br>" + meta
+	}
+	return desc
+}
+
+// renderValuesTable은 @Enum 또는 상수 스크립트의 프로퍼티를 이름, 값, 의미 테이블로 만듭니다.
+func renderValuesTable(doc *document.Documentation, page Page) string {
+	if len(doc.Properties) == 0 {
+		return ""
+	}
+	const cell = `<td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;">`
+	const head = `<th style="background-color: #f0f0f0; padding: 10px 5px; text-align: left; vertical-align: top;">`
+
+	var b strings.Builder
+	b.WriteString("## Values\n\n")
+	b.WriteString(`<table style="width: 100%; border-collapse: collapse; border: 1px solid #ccc; margin-bottom: 16px;">`)
+	b.WriteString(`<thead><tr>` + head + `Name</th>` + head + `Value</th>` + head + `Description</th></tr></thead><tbody>`)
+	for _, p := range doc.Properties {
+		value := ""
+		if p.DefaultValue != "" {
+			value = "<code>" + html.EscapeString(p.DefaultValue) + "</code>"
 		}
+		b.WriteString(fmt.Sprintf(`<tr>%s%s<strong>%s</strong>%s</td>%s%s</td>%s%s</td></tr>`,
+			cell, anchorHTML(memberAnchor("property", p.Name)), memberNameHTML(deprecatedName(p.Name, p.Deprecated), sourceLineLink(page.SourceLink, p.Span)), propertyBadges(p),
+			cell, value, cell, propertyDescriptionHTML(p, doc, page)))
+	}
+	b.WriteString("</tbody></table>\n\n")
+	return b.String()
+}
+
+// memberNames는 doc에 정의된 프로퍼티, 메서드, 핸들러 이름의 집합을 반환합니다.
+func memberNames(doc *document.Documentation) map[string]bool {
+	names := make(map[string]bool)
+	for _, p := range doc.Properties {
+		names[p.Name] = true
 	}
+	for _, m := range doc.Methods {
+		names[m.Name] = true
+	}
+	for _, h := range doc.Handlers {
+		names[h.Name] = true
+	}
+	return names
+}
 
-	badge, _ := Badges[m.ExecSpace]
+// renderInherited는 부모 스크립트에서 물려받은 멤버 목록을 만듭니다.
+// own에 있는 이름(자식이나 더 가까운 조상이 같은 이름으로 다시 정의한 멤버)은 제외합니다.
+func renderInherited(parent *ProjectScript, own map[string]bool, pagePath string) string {
+	// 부모의 페이지가 만들어지지 않으면 이름만 표시합니다.
+	link := func(name, anchor string) string {
+		if !parent.HasPage() {
+			return name
+		}
+		return fmt.Sprintf("[%s](%s%s)", name, relativeLink(pagePath, parent.Path), anchor)
+	}
+	var names [3][]string
+	for _, p := range parent.Doc.Properties {
+		if !own[p.Name] {
+			names[0] = append(names[0], link(p.Name, "#"+memberAnchor("property", p.Name)))
+		}
+	}
+	for _, m := range parent.Doc.Methods {
+		if !own[m.Name] {
+			names[1] = append(names[1], link(m.Name, "#"+memberAnchor("method", m.Name)))
+		}
+	}
+	for _, h := range parent.Doc.Handlers {
+		if !own[h.Name] {
+			names[2] = append(names[2], link(h.Name, "#"+memberAnchor("handler", h.Name)))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("\n## Inherited from %s\n\n", link(parent.Name, "")))
+	for i, label := range []string{"Properties", "Methods", "Handlers"} {
+		if len(names[i]) > 0 {
+			b.WriteString(fmt.Sprintf("- **%s**: %s\n", label, strings.Join(names[i], ", ")))
+		}
+	}
+	if len(names[0])+len(names[1])+len(names[2]) == 0 {
+		b.WriteString("상속받은 멤버가 없습니다.\n")
+	}
+	return b.String()
+}
+
+// renderFunctionDoc은 메서드 문서를 function_doc.tmpl 템플릿을 사용하여 생성합니다.
+func renderFunctionDoc(m document.MethodDoc, typeLinks TypeLinkInfo, sourceLink string) (string, error) {
+	badge := attributeBadge("ExecSpace", m.ExecSpace)
+	badge += attributeBadges(m.Attributes)
+	if m.Local {
+		badge += Badges["Local"]
+	}
+	badge += sinceBadge(m.Since)
+	if m.Deprecated {
+		badge += Badges["Deprecated"]
+	}
 
 	data := FuncTmplData{
-		ReturnType:        m.ReturnType,
-		FunctionName:      m.Name,
-		FunctionParamsStr: template.HTML(paramsStrBuilder.String()),
-		BadgeHTML:         template.HTML(badge),
-		Description:       m.Description,
-		Params:            m.Params,
+		ReturnType:              m.ReturnType,
+		FunctionName:            m.Name,
+		FunctionNameHTML:        template.HTML(memberNameHTML(deprecatedName(m.Name, m.Deprecated), sourceLineLink(sourceLink, m.Span))),
+		FunctionParamsStr:       template.HTML(renderParamList(m.Params, typeLinks)),
+		BadgeHTML:               template.HTML(badge),
+		Description:             m.Description,
+		Params:                  m.Params,
+		ReturnTypeHTML:          template.HTML(createLinkForType(m.ReturnType, typeLinks)),
+		ReturnTypeSignatureHTML: template.HTML(paramTypeHTML(m.ReturnType, typeLinks)),
+		ReturnDescription:       m.ReturnDescription,
+		Deprecated:              m.Deprecated,
+		DeprecatedMessage:       m.DeprecatedMessage,
+		Metadata:                m.Metadata,
+		Anchor:                  memberAnchor("method", m.Name),
 	}
 
-	tmpl, err := template.New("function").Parse(DocumentTemplateInline)
+	tmpl, err := template.New("function").Funcs(templateFuncs).Parse(DocumentTemplateInline)
 	if err != nil {
 		return "", err
 	}
@@ -118,38 +341,41 @@ func renderFunctionDoc(m document.MethodDoc, typeLinks TypeLinkInfo) (string, er
 	return buf.String(), nil
 }
 
-func renderHandlerDoc(h document.HandlerDoc, typeLinks TypeLinkInfo) string {
-	var paramsStrBuilder strings.Builder
-	for i, p := range h.Params {
-		paramsStrBuilder.WriteString(fmt.Sprintf("%s %s", createLinkForType(p.Type, typeLinks), p.Name))
-		if i < len(h.Params)-1 {
-			paramsStrBuilder.WriteString(", ")
-		}
-	}
-
-	badge, _ := Badges[h.ExecSpace]
+func renderHandlerDoc(h document.HandlerDoc, typeLinks TypeLinkInfo, sourceLink string) string {
+	badge := attributeBadge("ExecSpace", h.ExecSpace)
 	if h.EventSenderType != "" {
-		eventSenderBadge, _ := Badges[h.EventSenderType]
-		badge += eventSenderBadge
+		badge += attributeBadge("EventSender", h.EventSenderType)
+	}
+	badge += attributeBadges(h.Attributes)
+	badge += sinceBadge(h.Since)
+	if h.Deprecated {
+		badge += Badges["Deprecated"]
 	}
 
 	// 핸들러는 반환 타입이 없을 수도 있음
 	var returnTypeSpan string
 	if h.ReturnType != "" {
-		returnTypeSpan = fmt.Sprintf(`<span style="color: #3167ad;">%s</span> `, h.ReturnType)
+		returnTypeSpan = fmt.Sprintf(`<span style="color: #3167ad;">%s</span> `, paramTypeHTML(h.ReturnType, typeLinks))
 	}
 
 	// 헤더 생성
-	header := fmt.Sprintf(`%s<span style="font-weight: bold;">%s</span>(%s)%s`,
-		returnTypeSpan, h.Name, paramsStrBuilder.String(), badge)
+	header := fmt.Sprintf(`%s%s<span style="font-weight: bold;">%s</span>(%s)%s`,
+		anchorHTML(memberAnchor("handler", h.Name)), returnTypeSpan, memberNameHTML(deprecatedName(h.Name, h.Deprecated), sourceLineLink(sourceLink, h.Span)), renderParamList(h.Params, typeLinks), badge)
 
 	// 본문 내용 생성
 	var bodyContent strings.Builder
 
+	if h.Deprecated {
+		bodyContent.WriteString(fmt.Sprintf(
+			`<tr><td style="background-color: #fff8c5; padding: 10px 5px; text-align: left; vertical-align: top;">%s</td></tr>`,
+			deprecationNoteHTML(h.DeprecatedMessage),
+		))
+	}
+
 	if h.Description != "" {
 		bodyContent.WriteString(fmt.Sprintf(
 			`<tr><td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;">%s</td></tr>`,
-			h.Description,
+			renderMarkdown(h.Description),
 		))
 	}
 
@@ -161,16 +387,40 @@ func renderHandlerDoc(h document.HandlerDoc, typeLinks TypeLinkInfo) string {
 		))
 	}
 
+	// 구독하는 이벤트
+	if h.EventType != "" {
+		bodyContent.WriteString(fmt.Sprintf(
+			`<tr><td style="background-color: #fafafa; border-top: 1px solid #eee; padding: 10px 5px 10px 15px; text-align: left; vertical-align: top;"><strong>Event:</strong> %s</td></tr>`,
+			createLinkForType(h.EventType, typeLinks),
+		))
+	}
+
 	// 파라미터 설명
 	for _, p := range h.Params {
 		if p.Description != "" {
 			bodyContent.WriteString(fmt.Sprintf(
 				`<tr><td style="background-color: #fafafa; border-top: 1px solid #eee; padding: 10px 5px 10px 15px; text-align: left; vertical-align: top;"><code style="background-color: #e1e4e8; padding: 2px 5px; border-radius: 4px; font-family: monospace;">%s</code><span style="color: #57606a;"> &nbsp;|&nbsp; %s</span></td></tr>`,
-				p.Name, p.Description,
+				p.Name, renderMarkdown(p.Description)+defaultNote(p.Default),
 			))
 		}
 	}
 
+	// 반환 값 설명
+	if h.ReturnDescription != "" {
+		bodyContent.WriteString(fmt.Sprintf(
+			`<tr><td style="background-color: #fafafa; border-top: 1px solid #eee; padding: 10px 5px 10px 15px; text-align: left; vertical-align: top;"><strong>Returns</strong> %s<span style="color: #57606a;"> &nbsp;|&nbsp; %s</span></td></tr>`,
+			createLinkForType(h.ReturnType, typeLinks), renderMarkdown(h.ReturnDescription),
+		))
+	}
+
+	// 알 수 없는 태그
+	for _, name := range metadataNames(h.Metadata) {
+		bodyContent.WriteString(fmt.Sprintf(
+			`<tr><td style="background-color: #fafafa; border-top: 1px solid #eee; padding: 10px 5px 10px 15px; text-align: left; vertical-align: top;">%s</td></tr>`,
+			metadataItemHTML(name, h.Metadata[name]),
+		))
+	}
+
 	// 완전한 테이블 생성
 	table := fmt.Sprintf(
 		`<table style="width: 100%%; border-collapse: collapse; border: 1px solid #ccc; margin-bottom: 16px;"><thead><tr><th style="background-color: #f0f0f0; padding: 10px 5px; text-align: left; vertical-align: top;">%s</th></tr></thead><tbody>%s</tbody></table>`,
@@ -180,13 +430,234 @@ func renderHandlerDoc(h document.HandlerDoc, typeLinks TypeLinkInfo) string {
 	return table
 }
 
+// renderParamList는 시그니처의 파라미터 목록을 만듭니다. 문서가 있는 타입만 링크하고,
+// 기본값이 있으면 `= 값`을 붙입니다. 선택 여부는 타입의 `?`나 기본값으로 드러납니다.
+func renderParamList(params []document.ParamInfo, typeLinks TypeLinkInfo) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		part := p.Name
+		if p.Type != "" {
+			part = paramTypeHTML(p.Type, typeLinks) + " " + p.Name
+		}
+		if p.Default != "" {
+			part += " = " + html.EscapeString(p.Default)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, ", ")
+}
+
+// paramTypeHTML은 파라미터 타입을 글자 그대로 두되, 문서가 있는 타입 이름마다 링크를 겁니다.
+func paramTypeHTML(typeName string, typeLinks TypeLinkInfo) string {
+	expr, err := document.ParseTypeExpr(typeName)
+	if err != nil {
+		return html.EscapeString(typeName)
+	}
+	return typeExprHTML(expr, typeLinks)
+}
+
+// defaultNote는 파라미터 설명 뒤에 붙일 기본값 안내를 만듭니다.
+func defaultNote(def string) string {
+	if def == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (기본값: <code>%s</code>)", html.EscapeString(def))
+}
+
+// renderExamples는 예제를 ```lua 코드 블록으로 만듭니다. HTML 테이블 뒤에서도 Markdown으로
+// 해석되도록 앞뒤에 빈 줄을 둡니다. 제목이 없는 예제에는 defaultTitle을 붙입니다.
+func renderExamples(examples []document.Example, defaultTitle string) string {
+	var b strings.Builder
+	for _, ex := range examples {
+		title := ex.Title
+		if title == "" {
+			title = defaultTitle
+		}
+		b.WriteString("\n\n**Example**")
+		if title != "" {
+			b.WriteString(": " + title)
+		}
+		fence := "```"
+		for strings.Contains(ex.Code, fence) {
+			fence += "`"
+		}
+		b.WriteString(fmt.Sprintf("\n\n%slua\n%s\n%s\n\n", fence, ex.Code, fence))
+	}
+	return b.String()
+}
+
+// metadataNames는 메타데이터의 태그 이름을 정렬하여 반환합니다.
+func metadataNames(meta map[string]string) []string {
+	names := make([]string, 0, len(meta))
+	for name := range meta {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// metadataItemHTML은 메타데이터 하나를 `<strong>이름</strong> | 내용` 형태로 만듭니다.
+func metadataItemHTML(name, value string) string {
+	item := "<strong>" + html.EscapeString(name) + "</strong>"
+	if value != "" {
+		item += fmt.Sprintf(`<span style="color: #57606a;"> &nbsp;|&nbsp; %s</span>`, renderMarkdown(value))
+	}
+	return item
+}
+
+// metadataHTML은 메타데이터 전체를 <br>로 이어 한 줄의 HTML로 만듭니다.
+func metadataHTML(meta map[string]string) string {
+	items := make([]string, 0, len(meta))
+	for _, name := range metadataNames(meta) {
+		items = append(items, metadataItemHTML(name, meta[name]))
+	}
+	return strings.Join(items, "<br>")
+}
+
+// attributeBadge는 어트리뷰트 하나의 뱃지를 찾습니다. `이름:첫 번째 인자` 키를 먼저 찾고, 없으면 `이름` 키를 씁니다.
+func attributeBadge(name string, args ...string) string {
+	if len(args) > 0 {
+		if badge, ok := Badges[name+":"+args[0]]; ok {
+			return badge
+		}
+	}
+	return Badges[name]
+}
+
+// attributeBadges는 선언에 붙은 어트리뷰트 중 뱃지가 있는 것을 모두 뱃지로 만듭니다.
+// @ExecSpace와 @EventSender는 ExecSpace, EventSenderType 필드로 따로 표시하므로 건너뜁니다.
+func attributeBadges(attrs []document.Attribute) string {
+	var b strings.Builder
+	for _, a := range attrs {
+		if a.Name == "ExecSpace" || a.Name == "EventSender" {
+			continue
+		}
+		b.WriteString(attributeBadge(a.Name, a.Args...))
+	}
+	return b.String()
+}
+
+// versionLine은 스크립트 제목 아래에 둘 since/version 줄을 만듭니다. 둘 다 없으면 빈 문자열입니다.
+func versionLine(since, version string) string {
+	var parts []string
+	if since != "" {
+		parts = append(parts, "<strong>since</strong> "+html.EscapeString(since))
+	}
+	if version != "" {
+		parts = append(parts, "<strong>version</strong> "+html.EscapeString(version))
+	}
+	return strings.Join(parts, " &nbsp;|&nbsp; ")
+}
+
+// sinceBadge는 멤버가 추가된 버전을 뱃지로 만듭니다. 버전이 없으면 빈 문자열입니다.
+func sinceBadge(since string) string {
+	if since == "" {
+		return ""
+	}
+	// shields.io 경로에서는 -와 _를 두 번 써서 구분자와 구별합니다.
+	label := strings.NewReplacer("-", "--", "_", "__").Replace(since)
+	return fmt.Sprintf(` <img src="https://img.shields.io/badge/Since-%s-0969da" alt="Since %s" style="vertical-align: middle; margin-left: 8px;">`,
+		url.PathEscape(label), html.EscapeString(since))
+}
+
+// deprecatedName은 사용 중단된 멤버의 이름에 취소선을 긋습니다.
+func deprecatedName(name string, deprecated bool) string {
+	if !deprecated {
+		return name
+	}
+	return "<del>" + name + "</del>"
+}
+
+// deprecatedTitle은 사용 중단된 스크립트의 제목에 Markdown 취소선을 긋습니다.
+func deprecatedTitle(title string, deprecated bool) string {
+	if !deprecated {
+		return title
+	}
+	return "~~" + title + "~~"
+}
+
+// deprecatedSuffix는 사용 중단 안내 문구를 `: 문구` 형태로 만듭니다. 문구가 없으면 빈 문자열입니다.
+func deprecatedSuffix(message string) string {
+	if message == "" {
+		return ""
+	}
+	return ": " + message
+}
+
+// deprecationNoteHTML은 테이블 셀 안에 들어갈 사용 중단 안내를 만듭니다.
+func deprecationNoteHTML(message string) string {
+	note := "<strong>Deprecated</strong>"
+	if message != "" {
+		note += " &nbsp;|&nbsp; " + renderMarkdown(message)
+	}
+	return note
+}
+
+// sourceLineLink는 원본 파일 링크에 선언이 시작되는 줄의 앵커(#L42)를 붙입니다.
+func sourceLineLink(sourceLink string, span document.Span) string {
+	if sourceLink == "" || span.Start.Line == 0 {
+		return sourceLink
+	}
+	return fmt.Sprintf("%s#L%d", sourceLink, span.Start.Line)
+}
+
+// memberNameHTML은 멤버 이름을 선언 위치 링크로 감쌉니다. 링크가 없으면 이름만 반환합니다.
+func memberNameHTML(name, link string) string {
+	if link == "" {
+		return name
+	}
+	return fmt.Sprintf(`<a href="%s" style="text-decoration: none; color: inherit;">%s</a>`, link, name)
+}
+
+// createLinkForType은 타입을 색이 있는 HTML로 만듭니다. 제네릭, 유니온, 배열, nullable 타입은
+// 표현식을 해석하여 문서가 있는 타입 이름마다 따로 링크합니다.
 func createLinkForType(typeName string, typeLinks TypeLinkInfo) string {
-	baseType := strings.TrimSuffix(strings.TrimSpace(strings.Split(typeName, ",")[0]), ">")
+	if link, ok := typeLinks[typeName]; ok {
+		// 링크가 있으면 a 태그로 감싸고 inline style 추가
+		return typeLinkHTML(typeName, link)
+	}
+	expr, err := document.ParseTypeExpr(typeName)
+	if err != nil || (expr.Kind == document.TypeNamed && len(expr.Args) == 0) {
+		// 링크가 없으면 span으로 감싸서 스타일만 적용
+		return fmt.Sprintf(`<span style="color: #3167ad;">%s</span>`, html.EscapeString(typeName))
+	}
+	return fmt.Sprintf(`<span style="color: #3167ad;">%s</span>`, typeExprHTML(expr, typeLinks))
+}
 
-	if link, ok := typeLinks[baseType]; ok {
-		// ��크가 있으면 a 태그로 감싸고 inline style 추가
-		return fmt.Sprintf(`<a href="%s" style="text-decoration: none; color: #3167ad;">%s</a>`, link, typeName)
+// typeExprHTML은 타입 표현식을 HTML로 만들고, 문서가 있는 타입 이름에 링크를 겁니다.
+func typeExprHTML(t *document.TypeExpr, typeLinks TypeLinkInfo) string {
+	join := func(types []*document.TypeExpr, sep string) string {
+		items := make([]string, len(types))
+		for i, a := range types {
+			items[i] = typeExprHTML(a, typeLinks)
+		}
+		return strings.Join(items, sep)
+	}
+	operand := func(t *document.TypeExpr) string {
+		if t.Kind == document.TypeUnion {
+			return "(" + typeExprHTML(t, typeLinks) + ")"
+		}
+		return typeExprHTML(t, typeLinks)
+	}
+
+	switch t.Kind {
+	case document.TypeUnion:
+		return join(t.Args, " | ")
+	case document.TypeArray:
+		return operand(t.Elem) + "[]"
+	case document.TypeNullable:
+		return operand(t.Elem) + "?"
 	}
-	// 링크가 없으면 span으로 감싸서 스타일만 적용
-	return fmt.Sprintf(`<span style="color: #3167ad;">%s</span>`, typeName)
+	name := html.EscapeString(t.Name)
+	if link, ok := typeLinks[t.Name]; ok {
+		name = typeLinkHTML(t.Name, link)
+	}
+	if len(t.Args) > 0 {
+		name += "&lt;" + join(t.Args, ", ") + "&gt;"
+	}
+	return name
+}
+
+func typeLinkHTML(typeName, link string) string {
+	return fmt.Sprintf(`<a href="%s" style="text-decoration: none; color: #3167ad;">%s</a>`, link, html.EscapeString(typeName))
 }
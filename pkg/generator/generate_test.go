@@ -91,7 +91,7 @@ func TestRenderHandlerDoc(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			html := renderHandlerDoc(tt.handler, typeLinks)
+			html := renderHandlerDoc(tt.handler, typeLinks, "")
 
 			// Check if badge is present
 			if !strings.Contains(html, tt.expectBadge) {
@@ -123,7 +123,7 @@ func TestRenderHandlerDocWithReturnType(t *testing.T) {
 		},
 	}
 
-	html := renderHandlerDoc(handler, typeLinks)
+	html := renderHandlerDoc(handler, typeLinks, "")
 
 	// Check if return type is present
 	if !strings.Contains(html, "void") {
@@ -156,7 +156,7 @@ func TestRenderHandlerDocWithoutEventSender(t *testing.T) {
 		ExecSpace:   "ServerOnly",
 	}
 
-	html := renderHandlerDoc(handler, typeLinks)
+	html := renderHandlerDoc(handler, typeLinks, "")
 
 	// Should not contain EventSender badges
 	eventSenderBadges := []string{"Entity", "Model", "Logic", "Service", "LocalPlayer", "Self"}
@@ -173,3 +173,559 @@ func TestRenderHandlerDocWithoutEventSender(t *testing.T) {
 		t.Error("Expected ServerOnly badge not found in output")
 	}
 }
+
+func TestGenerateLinksMembersToSourceLines(t *testing.T) {
+	doc := &document.Documentation{
+		Properties: []document.PropertyDoc{
+			{Name: "speed", Type: "number", Span: document.Span{Start: document.Pos{Line: 5, Column: 2}}},
+		},
+		Methods: []document.MethodDoc{
+			{Name: "Move", ReturnType: "void", Span: document.Span{Start: document.Pos{Line: 12, Column: 2}}},
+		},
+		Handlers: []document.HandlerDoc{
+			{Name: "OnTick", ReturnType: "handler", Span: document.Span{Start: document.Pos{Line: 20, Column: 2}}},
+		},
+	}
+
+	md, err := Generate(doc, Page{Title: "GameLogic", SourceLink: "../../GameLogic.mlua"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	for _, link := range []string{"GameLogic.mlua#L5", "GameLogic.mlua#L12", "GameLogic.mlua#L20"} {
+		if !strings.Contains(md, link) {
+			t.Errorf("Expected source link %s not found in output", link)
+		}
+	}
+}
+
+func TestGenerateUsesScriptName(t *testing.T) {
+	typeLinks := TypeLinkInfo{"BaseMover": "BaseMover.md"}
+
+	named := &document.Documentation{Name: "PlayerMover", Extends: "BaseMover"}
+	md, err := Generate(named, Page{Title: "player_mover", SourceLink: "player_mover.mlua", TypeLinks: typeLinks})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.HasPrefix(md, "# [PlayerMover](player_mover.mlua)") {
+		t.Errorf("Expected title to use script name, got %q", strings.SplitN(md, "\n", 2)[0])
+	}
+	if !strings.Contains(md, `<a href="BaseMover.md"`) {
+		t.Error("Expected extends link to BaseMover.md not found in output")
+	}
+
+	unnamed := &document.Documentation{}
+	md, err = Generate(unnamed, Page{Title: "player_mover", SourceLink: "player_mover.mlua", TypeLinks: typeLinks})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.HasPrefix(md, "# [player_mover](player_mover.mlua)") {
+		t.Errorf("Expected title to fall back to file name, got %q", strings.SplitN(md, "\n", 2)[0])
+	}
+}
+
+func TestGenerateInheritedMembers(t *testing.T) {
+	base := &document.Documentation{
+		DocType: "Component",
+		Name:    "BaseMover",
+		Extends: "Component",
+		Properties: []document.PropertyDoc{
+			{Name: "speed", Type: "number"},
+		},
+		Methods: []document.MethodDoc{
+			{Name: "Move", ReturnType: "void"},
+			{Name: "Stop", ReturnType: "void"},
+		},
+	}
+	child := &document.Documentation{
+		DocType: "Component",
+		Name:    "PlayerMover",
+		Extends: "BaseMover",
+		Methods: []document.MethodDoc{
+			{Name: "Move", ReturnType: "void"},
+		},
+	}
+	root := &document.Documentation{Name: "Root", Extends: "PlayerMover"}
+
+	project := NewProject()
+	project.Add("BaseMover", base, "component/BaseMover.md")
+	project.Add("PlayerMover", child, "component/PlayerMover.md")
+	project.Add("Root", root, "logic/Root.md")
+
+	md, err := Generate(child, Page{Title: "PlayerMover", Path: "component/PlayerMover.md", Project: project})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.Contains(md, "## Inherited from [BaseMover](BaseMover.md)") {
+		t.Error("Expected inherited section for BaseMover not found in output")
+	}
+	if !strings.Contains(md, "[speed](BaseMover.md#property-speed)") || !strings.Contains(md, "[Stop](BaseMover.md#method-Stop)") {
+		t.Error("Expected inherited members speed and Stop not found in output")
+	}
+	if strings.Contains(md, "[Move](BaseMover.md#method-Move)") {
+		t.Error("Overridden method Move should not be listed as inherited")
+	}
+
+	md, err = Generate(root, Page{Title: "Root", Path: "logic/Root.md", Project: project})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.Contains(md, "## Inherited from [PlayerMover](../component/PlayerMover.md)") ||
+		!strings.Contains(md, "## Inherited from [BaseMover](../component/BaseMover.md)") {
+		t.Error("Expected inherited sections for the whole ancestor chain")
+	}
+	if !strings.Contains(md, "[Move](../component/PlayerMover.md#method-Move)") {
+		t.Error("Expected Move to be inherited from PlayerMover")
+	}
+	if strings.Contains(md, "[Move](../component/BaseMover.md#method-Move)") {
+		t.Error("Move overridden by PlayerMover should not be listed again for BaseMover")
+	}
+}
+
+func TestRenderFunctionDocMarkdownDescription(t *testing.T) {
+	method := document.MethodDoc{
+		Name:        "Spawn",
+		ReturnType:  "void",
+		Description: "Spawns a monster.\n\n- uses `pool`\n- returns early",
+		Params: []document.ParamInfo{
+			{Name: "count", Type: "number", Description: "Must be `> 0`"},
+		},
+	}
+
+	html, err := renderFunctionDoc(method, make(TypeLinkInfo), "")
+	if err != nil {
+		t.Fatalf("renderFunctionDoc() error = %v", err)
+	}
+	if !strings.Contains(html, "<ul><li>uses <code>pool</code></li><li>returns early</li></ul>") {
+		t.Errorf("Expected rendered list not found in output: %s", html)
+	}
+	if !strings.Contains(html, "Must be <code>&gt; 0</code>") {
+		t.Errorf("Expected rendered parameter code span not found in output: %s", html)
+	}
+}
+
+func TestRenderReturnsRow(t *testing.T) {
+	typeLinks := TypeLinkInfo{"Item": "../struct/Item.md"}
+
+	method := document.MethodDoc{
+		Name:              "FindItem",
+		ReturnType:        "Item",
+		ReturnDescription: "`nil` when sold out",
+	}
+	html, err := renderFunctionDoc(method, typeLinks, "")
+	if err != nil {
+		t.Fatalf("renderFunctionDoc() error = %v", err)
+	}
+	if !strings.Contains(html, "<strong>Returns</strong>") || !strings.Contains(html, "<code>nil</code> when sold out") {
+		t.Errorf("Expected Returns row not found in output: %s", html)
+	}
+	if !strings.Contains(html, `<a href="../struct/Item.md"`) {
+		t.Error("Expected linked return type not found in output")
+	}
+
+	handler := document.HandlerDoc{Name: "OnBuy", ReturnType: "boolean", ReturnDescription: "Whether it was handled"}
+	if html := renderHandlerDoc(handler, typeLinks, ""); !strings.Contains(html, "<strong>Returns</strong>") {
+		t.Errorf("Expected Returns row in handler output: %s", html)
+	}
+
+	method.ReturnDescription = ""
+	html, _ = renderFunctionDoc(method, typeLinks, "")
+	if strings.Contains(html, "Returns") {
+		t.Error("Returns row should be omitted without a description")
+	}
+}
+
+func TestGenerateDeprecated(t *testing.T) {
+	doc := &document.Documentation{
+		Name:              "OldLogic",
+		Deprecated:        true,
+		DeprecatedMessage: "Use NewLogic instead",
+		Properties: []document.PropertyDoc{
+			{Name: "speed", Type: "number", Deprecated: true},
+		},
+		Methods: []document.MethodDoc{
+			{Name: "SendOld", ReturnType: "void", Deprecated: true, DeprecatedMessage: "Use `Send` instead"},
+		},
+		Handlers: []document.HandlerDoc{
+			{Name: "OnConnect", ReturnType: "handler", Deprecated: true, DeprecatedMessage: "Use OnJoin"},
+		},
+	}
+
+	md, err := Generate(doc, Page{Title: "OldLogic", SourceLink: "OldLogic.mlua"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	expected := []string{
+		"# [~~OldLogic~~](OldLogic.mlua)",
+		"> **Deprecated**: Use NewLogic instead",
+		"<del>speed</del>",
+		"<del>SendOld</del>",
+		"Use <code>Send</code> instead",
+		"<del>OnConnect</del>",
+		"Use OnJoin",
+		"badge/Deprecated",
+	}
+	for _, e := range expected {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output", e)
+		}
+	}
+}
+
+func TestGenerateExamples(t *testing.T) {
+	doc := &document.Documentation{
+		Name: "Spawner",
+		Properties: []document.PropertyDoc{
+			{Name: "maxHp", Type: "number", Examples: []document.Example{{Code: "local hp = _Spawner.maxHp"}}},
+		},
+		Methods: []document.MethodDoc{
+			{Name: "Spawn", ReturnType: "void", Examples: []document.Example{
+				{Title: "Spawning", Code: "_Spawner:Spawn(\"slime\")"},
+				{Code: "local s = \"```\""},
+			}},
+		},
+	}
+
+	md, err := Generate(doc, Page{Title: "Spawner"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	expected := []string{
+		"</table>\n\n\n**Example**: Spawning\n\n```lua\n_Spawner:Spawn(\"slime\")\n```\n\n",
+		"**Example**: maxHp\n\n```lua\nlocal hp = _Spawner.maxHp\n```",
+		"````lua\nlocal s = \"```\"\n````",
+	}
+	for _, e := range expected {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, md)
+		}
+	}
+}
+
+func TestGenerateSince(t *testing.T) {
+	doc := &document.Documentation{
+		Name:       "Shop",
+		Since:      "1.2.0",
+		Version:    "1.4.0",
+		Properties: []document.PropertyDoc{{Name: "discount", Type: "number", Since: "1.4.0"}},
+		Methods:    []document.MethodDoc{{Name: "Buy", ReturnType: "void", Since: "1.4.0-rc_1"}},
+	}
+
+	md, err := Generate(doc, Page{Title: "Shop"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	expected := []string{
+		"<strong>since</strong> 1.2.0 &nbsp;|&nbsp; <strong>version</strong> 1.4.0\n\n",
+		`https://img.shields.io/badge/Since-1.4.0-0969da" alt="Since 1.4.0"`,
+		`https://img.shields.io/badge/Since-1.4.0--rc__1-0969da" alt="Since 1.4.0-rc_1"`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, md)
+		}
+	}
+}
+
+func TestGenerateMetadata(t *testing.T) {
+	meta := map[string]string{"ticket": "GAME-101", "owner": "items", "perf": ""}
+	doc := &document.Documentation{
+		Name:       "Inventory",
+		Metadata:   map[string]string{"owner": "gameplay-team"},
+		Properties: []document.PropertyDoc{{Name: "slots", Type: "number", Metadata: map[string]string{"perf": ""}}},
+		Methods:    []document.MethodDoc{{Name: "AddItem", ReturnType: "void", Metadata: meta}},
+		Handlers:   []document.HandlerDoc{{Name: "OnPickup", Metadata: meta}},
+	}
+
+	md, err := Generate(doc, Page{Title: "Inventory"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if !strings.Contains(md, `<strong>owner</strong><span style="color: #57606a;"> &nbsp;|&nbsp; gameplay-team</span>`+"\n\n") {
+		t.Errorf("Script metadata not rendered:\n%s", md)
+	}
+	if !strings.Contains(md, "<br><strong>perf</strong></td>") {
+		t.Errorf("Property metadata not rendered:\n%s", md)
+	}
+	// 메서드와 핸들러 모두 태그 이름 순으로 표시합니다.
+	methods, handlers, _ := strings.Cut(strings.SplitN(md, "## Methods", 2)[1], "## Handlers")
+	for _, section := range []string{methods, handlers} {
+		owner, perf, ticket := strings.Index(section, "<strong>owner</strong>"), strings.Index(section, "<strong>perf</strong>"), strings.Index(section, "<strong>ticket</strong>")
+		if owner < 0 || !(owner < perf && perf < ticket) {
+			t.Errorf("Metadata rows missing or out of order:\n%s", section)
+		}
+	}
+}
+
+func TestGeneratePropertyAttributeBadges(t *testing.T) {
+	doc := &document.Documentation{
+		Name: "Player",
+		Properties: []document.PropertyDoc{
+			{Name: "hp", Type: "number", ExecSpace: "ServerOnly", Attributes: []document.Attribute{
+				{Name: "Sync"}, {Name: "HideFromInspector"}, {Name: "ExecSpace", Args: []string{"ServerOnly"}}, {Name: "Range", Args: []string{"0", "100"}},
+			}},
+		},
+	}
+
+	md, err := Generate(doc, Page{Title: "Player"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	want := "<strong>hp</strong>" + Badges["ExecSpace:ServerOnly"] + Badges["Sync"] + Badges["HideFromInspector"] + "</td>"
+	if !strings.Contains(md, want) {
+		t.Errorf("Expected badges %q not found in output:\n%s", want, md)
+	}
+}
+
+func TestAttributeBadgeKeys(t *testing.T) {
+	Badges["RateLimit:5"] = " rate-limit-5"
+	Badges["Experimental"] = " experimental"
+	defer delete(Badges, "RateLimit:5")
+	defer delete(Badges, "Experimental")
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"ExecSpace", []string{"ServerOnly"}, Badges["ExecSpace:ServerOnly"]},
+		{"ExecSpace", []string{"All"}, ""},
+		{"RateLimit", []string{"5", "second"}, " rate-limit-5"},
+		{"RateLimit", []string{"10"}, ""},
+		{"Experimental", []string{"anything"}, " experimental"},
+		{"Experimental", nil, " experimental"},
+	}
+	for _, tt := range tests {
+		if got := attributeBadge(tt.name, tt.args...); got != tt.want {
+			t.Errorf("attributeBadge(%q, %q) = %q, want %q", tt.name, tt.args, got, tt.want)
+		}
+	}
+
+	m := document.MethodDoc{
+		Name:       "Enqueue",
+		ReturnType: "void",
+		ExecSpace:  "ServerOnly",
+		Attributes: []document.Attribute{{Name: "ExecSpace", Args: []string{"ServerOnly"}}, {Name: "RateLimit", Args: []string{"5"}}, {Name: "Experimental"}},
+	}
+	html, err := renderFunctionDoc(m, TypeLinkInfo{}, "")
+	if err != nil {
+		t.Fatalf("renderFunctionDoc() error = %v", err)
+	}
+	if strings.Count(html, "badge/ServerOnly") != 1 || !strings.Contains(html, Badges["ExecSpace:ServerOnly"]+" rate-limit-5 experimental") {
+		t.Errorf("Expected ExecSpace badge once followed by attribute badges, got:\n%s", html)
+	}
+}
+
+func TestGenerateMethodSections(t *testing.T) {
+	doc := &document.Documentation{
+		Name: "Spawner",
+		Methods: []document.MethodDoc{
+			{Name: "OnBeginPlay", ReturnType: "void", Lifecycle: true},
+			{Name: "NextId", ReturnType: "number", Static: true},
+			{Name: "Spawn", ReturnType: "void"},
+			{Name: "clamp", Local: true},
+		},
+		Handlers: []document.HandlerDoc{{Name: "OnSpawned"}},
+	}
+
+	md, err := Generate(doc, Page{Title: "Spawner"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	order := []string{"## Methods", "Spawn", "clamp</span>()" + Badges["Local"], "## Static Methods", "NextId", "## Lifecycle", "OnBeginPlay", "## Handlers"}
+	rest := md
+	for _, s := range order {
+		i := strings.Index(rest, s)
+		if i < 0 {
+			t.Fatalf("Expected %q in order, not found in output:\n%s", s, md)
+		}
+		rest = rest[i+len(s):]
+	}
+}
+
+func TestGenerateValuesTable(t *testing.T) {
+	doc := &document.Documentation{
+		Name:      "ItemGrade",
+		DocType:   "Enum",
+		Constants: true,
+		Properties: []document.PropertyDoc{
+			{Name: "Common", Type: "number", DefaultValue: "0", Description: "Dropped *everywhere*"},
+			{Name: "Legacy", Type: "number", DefaultValue: "9", Deprecated: true},
+		},
+	}
+
+	md, err := Generate(doc, Page{Title: "ItemGrade"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if strings.Contains(md, "## Properties") {
+		t.Errorf("Constant tables should not render a Properties section:\n%s", md)
+	}
+	expected := []string{
+		"## Values\n\n",
+		">Name</th>",
+		"<strong>Common</strong></td>",
+		"<code>0</code></td>",
+		"Dropped <em>everywhere</em></td>",
+		"<strong><del>Legacy</del></strong>",
+	}
+	for _, e := range expected {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, md)
+		}
+	}
+}
+
+func TestRenderParamDefaults(t *testing.T) {
+	typeLinks := TypeLinkInfo{"MoveOptions": "../struct/MoveOptions.md"}
+	m := document.MethodDoc{
+		Name:       "Move",
+		ReturnType: "void",
+		Params: []document.ParamInfo{
+			{Name: "speed", Type: "number", Default: "1.0", Optional: true, Description: "Move speed"},
+			{Name: "target", Type: "any?", Optional: true},
+			{Name: "options", Type: "MoveOptions"},
+			{Name: "label", Type: "string", Default: `"<none>"`, Optional: true},
+		},
+	}
+
+	html, err := renderFunctionDoc(m, typeLinks, "")
+	if err != nil {
+		t.Fatalf("renderFunctionDoc() error = %v", err)
+	}
+
+	expected := []string{
+		`(number speed = 1.0, any? target, <a href="../struct/MoveOptions.md" style="text-decoration: none; color: #3167ad;">MoveOptions</a> options, string label = &#34;&lt;none&gt;&#34;)`,
+		"Move speed (기본값: <code>1.0</code>)",
+	}
+	for _, e := range expected {
+		if !strings.Contains(html, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, html)
+		}
+	}
+
+	h := renderHandlerDoc(document.HandlerDoc{Name: "OnMove", Params: m.Params[:1]}, typeLinks, "")
+	if !strings.Contains(h, "(number speed = 1.0)") || !strings.Contains(h, "Move speed (기본값: <code>1.0</code>)") {
+		t.Errorf("Handler signature or default note missing:\n%s", h)
+	}
+}
+
+func TestCreateLinkForTypeExpressions(t *testing.T) {
+	typeLinks := TypeLinkInfo{"Vector3Info": "../struct/Vector3Info.md", "DamageInfo": "../struct/DamageInfo.md"}
+	link := func(name string) string {
+		return `<a href="` + typeLinks[name] + `" style="text-decoration: none; color: #3167ad;">` + name + `</a>`
+	}
+
+	tests := []struct {
+		typeName, want string
+	}{
+		{"DamageInfo", link("DamageInfo")},
+		{"number", `<span style="color: #3167ad;">number</span>`},
+		{"table<string, Vector3Info>", `<span style="color: #3167ad;">table&lt;string, ` + link("Vector3Info") + `&gt;</span>`},
+		{"DamageInfo[] | nil", `<span style="color: #3167ad;">` + link("DamageInfo") + `[] | nil</span>`},
+		{"(DamageInfo | Vector3Info)?", `<span style="color: #3167ad;">(` + link("DamageInfo") + ` | ` + link("Vector3Info") + `)?</span>`},
+		{"table<", `<span style="color: #3167ad;">table&lt;</span>`},
+	}
+	for _, tt := range tests {
+		if got := createLinkForType(tt.typeName, typeLinks); got != tt.want {
+			t.Errorf("createLinkForType(%q) = %q, want %q", tt.typeName, got, tt.want)
+		}
+	}
+
+	params := renderParamList([]document.ParamInfo{{Name: "hits", Type: "table<string, DamageInfo>"}}, typeLinks)
+	if want := "table&lt;string, " + link("DamageInfo") + "&gt; hits"; params != want {
+		t.Errorf("renderParamList() = %q, want %q", params, want)
+	}
+}
+
+func TestGenerateLinksTypesRelativeToPage(t *testing.T) {
+	combat := &document.Documentation{DocType: "Logic", Name: "Combat"}
+	mover := &document.Documentation{
+		DocType:    "Component",
+		Name:       "Mover",
+		Properties: []document.PropertyDoc{{Name: "combat", Type: "Combat"}},
+		Methods: []document.MethodDoc{{
+			Name:       "Follow",
+			ReturnType: "void",
+			Params:     []document.ParamInfo{{Name: "target", Type: "Mover"}},
+		}},
+	}
+	project := NewProject()
+	project.Add("Combat", combat, "logic/Combat.md")
+	project.Add("Mover", mover, "component/Mover.md")
+
+	md, err := Generate(mover, Page{Path: "component/Mover.md", TypeLinks: project.TypeLinks("component/Mover.md"), Project: project})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	for _, want := range []string{typeLinkHTML("Combat", "../logic/Combat.md"), typeLinkHTML("Mover", "Mover.md")} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Expected %q not found in output:\n%s", want, md)
+		}
+	}
+}
+
+func TestRenderSignatureReturnTypes(t *testing.T) {
+	typeLinks := TypeLinkInfo{"Item": "../item/Item.md"}
+	item := typeLinkHTML("Item", "../item/Item.md")
+
+	m, err := renderFunctionDoc(document.MethodDoc{Name: "Find", ReturnType: "Item[]"}, typeLinks, "")
+	if err != nil {
+		t.Fatalf("renderFunctionDoc() error = %v", err)
+	}
+	if want := `<span style="color: #3167ad;">` + item + `[]</span> `; !strings.Contains(m, want) {
+		t.Errorf("Expected %q not found in output:\n%s", want, m)
+	}
+
+	h := renderHandlerDoc(document.HandlerDoc{Name: "OnBuy", ReturnType: "table<string, Item>"}, typeLinks, "")
+	if want := `<span style="color: #3167ad;">table&lt;string, ` + item + `&gt;</span> `; !strings.Contains(h, want) {
+		t.Errorf("Expected %q not found in output:\n%s", want, h)
+	}
+	if strings.Contains(h, "<string") {
+		t.Errorf("Handler return type should be escaped:\n%s", h)
+	}
+}
+
+func TestGenerateValuesTableMemberDocs(t *testing.T) {
+	doc := &document.Documentation{
+		DocType:   "Enum",
+		Name:      "ItemGrade",
+		Constants: true,
+		Properties: []document.PropertyDoc{{
+			Name:         "Rare",
+			DefaultValue: "1",
+			Since:        "1.2",
+			Attributes:   []document.Attribute{{Name: "HideFromInspector"}},
+			See:          []document.SeeRef{{Target: "#Common"}},
+			Metadata:     map[string]string{"owner": "items"},
+			Examples:     []document.Example{{Code: "print(ItemGrade.Rare)"}},
+		}, {
+			Name:         "Common",
+			DefaultValue: "0",
+		}},
+	}
+
+	md, err := Generate(doc, Page{Path: "enum/ItemGrade.md"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	expected := []string{
+		"## Values",
+		"<strong>Rare</strong>" + Badges["HideFromInspector"] + sinceBadge("1.2") + "</td>",
+		`<strong>See also</strong>: <a href="#property-Common">Common</a>`,
+		metadataHTML(map[string]string{"owner": "items"}),
+		"**Example**: Rare\n\n```lua\nprint(ItemGrade.Rare)\n```",
+	}
+	for _, e := range expected {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, md)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Folders는 문서 페이지가 있는 폴더(출력 루트 기준, DocType별)를 이름 순으로 반환합니다.
+func (p *Project) Folders() []string {
+	seen := make(map[string]bool)
+	var folders []string
+	for _, s := range p.Pages() {
+		if dir := path.Dir(s.Path); !seen[dir] {
+			seen[dir] = true
+			folders = append(folders, dir)
+		}
+	}
+	sort.Strings(folders)
+	return folders
+}
+
+// ScriptsIn은 folder에 페이지가 있는 문서를 스크립트 이름 순으로 반환합니다. folder가 "."이면 페이지가 있는 모든 문서를 반환합니다.
+func (p *Project) ScriptsIn(folder string) []*ProjectScript {
+	var scripts []*ProjectScript
+	for _, s := range p.Pages() {
+		if folder == "." || path.Dir(s.Path) == folder {
+			scripts = append(scripts, s)
+		}
+	}
+	return scripts
+}
+
+// IndexPath는 folder의 색인 페이지 경로입니다. folder가 "."이면 출력 루트의 색인입니다.
+func IndexPath(folder string) string {
+	return path.Join(folder, "index.md")
+}
+
+// GenerateIndex는 folder의 색인 페이지를 만듭니다. 페이지 맨 위에는 그 폴더의 스크립트로 그린 클래스 다이어그램이 들어가고,
+// 그 아래에 스크립트마다 설명, 멤버 수, 뱃지를 보여 주는 목록이 이어집니다.
+// folder가 "."이면 프로젝트 전체의 색인을 만들고, 목록을 DocType 폴더별로 묶습니다.
+func GenerateIndex(project *Project, folder string) string {
+	scripts := project.ScriptsIn(folder)
+	indexPath := IndexPath(folder)
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("# %s\n\n", indexTitle(scripts, folder)))
+	if len(scripts) == 0 {
+		b.WriteString("문서화된 스크립트가 없습니다.\n")
+		return b.String()
+	}
+	b.WriteString("```mermaid\n")
+	b.WriteString(GenerateClassDiagram(scripts, project))
+	b.WriteString("```\n")
+
+	if folder != "." {
+		b.WriteString("\n" + scriptTable(scripts, indexPath))
+		return b.String()
+	}
+	for _, f := range project.Folders() {
+		group := project.ScriptsIn(f)
+		b.WriteString(fmt.Sprintf("\n## [%s](%s)\n\n", indexTitle(group, f), relativeLink(indexPath, IndexPath(f))))
+		b.WriteString(scriptTable(group, indexPath))
+	}
+	return b.String()
+}
+
+// scriptTable은 스크립트마다 링크, 뱃지, 설명, 멤버 수를 한 줄로 보여 주는 Markdown 테이블을 만듭니다.
+func scriptTable(scripts []*ProjectScript, indexPath string) string {
+	var b strings.Builder
+	b.WriteString("| Script | Description | Properties | Methods | Handlers |\n")
+	b.WriteString("| --- | --- | --- | --- | --- |\n")
+	for _, s := range scripts {
+		doc := s.Doc
+		badges := attributeBadges(doc.Attributes) + sinceBadge(doc.Since)
+		if doc.Deprecated {
+			badges += Badges["Deprecated"]
+		}
+		b.WriteString(fmt.Sprintf("| [%s](%s)%s | %s | %d | %d | %d |\n",
+			deprecatedName(s.Name, doc.Deprecated), relativeLink(indexPath, s.Path), badges,
+			tableCell(summary(doc.Description)), len(doc.Properties), len(doc.Methods), len(doc.Handlers)))
+	}
+	return b.String()
+}
+
+// summary는 설명의 첫 문단을 반환합니다.
+func summary(description string) string {
+	first, _, _ := strings.Cut(strings.TrimSpace(description), "\n\n")
+	return first
+}
+
+// indexTitle은 색인 페이지 제목입니다. DocType 폴더는 스크립트 종류 이름을 씁니다.
+func indexTitle(scripts []*ProjectScript, folder string) string {
+	if folder == "." {
+		return "API Reference"
+	}
+	for _, s := range scripts {
+		if s.Doc.DocType != "" {
+			return s.Doc.DocType
+		}
+	}
+	return path.Base(folder)
+}
@@ -0,0 +1,44 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+	"testing"
+)
+
+func TestGenerateIndexListsScripts(t *testing.T) {
+	project := classProject()
+	project.Add("OldMover", &document.Documentation{
+		DocType:     "Component",
+		Name:        "OldMover",
+		Description: "Legacy mover | kept for maps\n\nSecond paragraph",
+		Deprecated:  true,
+		Since:       "1.0",
+		Handlers:    []document.HandlerDoc{{Name: "OnTick"}},
+	}, "component/OldMover.md")
+
+	root := GenerateIndex(project, ".")
+	expected := []string{
+		"\n## [Component](component/index.md)\n\n| Script | Description | Properties | Methods | Handlers |\n",
+		"| [Mover](component/Mover.md) |  | 1 | 0 | 0 |\n",
+		"| [<del>OldMover</del>](component/OldMover.md)" + sinceBadge("1.0") + Badges["Deprecated"] + " | Legacy mover \\| kept for maps | 0 | 0 | 1 |\n",
+		"\n## [Logic](logic/index.md)\n",
+		"| [Combat](logic/Combat.md) |  | 1 | 2 | 0 |\n",
+	}
+	for _, e := range expected {
+		if !strings.Contains(root, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, root)
+		}
+	}
+	if strings.Index(root, "## [Component]") > strings.Index(root, "## [Logic]") {
+		t.Errorf("Groups should be sorted by folder:\n%s", root)
+	}
+
+	component := GenerateIndex(project, "component")
+	if !strings.Contains(component, "| [Mover](Mover.md) |") || strings.Contains(component, "## [") {
+		t.Errorf("Folder index should list its scripts relative to itself without groups:\n%s", component)
+	}
+	if strings.Index(component, "[Mover]") > strings.Index(component, "[<del>OldMover</del>]") {
+		t.Errorf("Scripts should be sorted by name:\n%s", component)
+	}
+}
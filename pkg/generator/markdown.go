@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// 설명은 HTML 테이블 셀 안에 들어가므로 GitHub가 Markdown으로 해석하지 않습니다.
+// 이 파일은 설명에 쓰이는 Markdown의 작은 부분집합(문단, 목록, 코드 스팬, 펜스 코드 블록,
+// 강조, 링크)을 직접 HTML로 바꿉니다. 출력에는 빈 줄이 없어야 HTML 블록이 끊기지 않으므로
+// 모든 결과를 한 줄로 만듭니다.
+
+var (
+	reFence       = regexp.MustCompile("^\\s*```\\s*([A-Za-z0-9_+-]*)\\s*$")
+	reListItem    = regexp.MustCompile(`^\s*([-*+]|\d+\.)\s+(.*)$`)
+	reBold        = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	reItalic      = regexp.MustCompile(`\*([^*]+)\*`)
+	reInlineLink  = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	reOrderedItem = regexp.MustCompile(`^\d+\.$`)
+)
+
+// renderMarkdown은 설명 Markdown을 한 줄짜리 HTML로 바꿉니다.
+// 문단 하나뿐인 설명은 <p> 없이 인라인 HTML만 반환하여 한 줄 설명의 모양을 유지합니다.
+func renderMarkdown(src string) string {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	var blocks []string
+	paragraphs := 0
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case strings.TrimSpace(line) == "":
+			i++
+
+		case reFence.MatchString(line):
+			lang := reFence.FindStringSubmatch(line)[1]
+			var code []string
+			for i++; i < len(lines) && !reFence.MatchString(lines[i]); i++ {
+				code = append(code, lines[i])
+			}
+			i++ // 닫는 펜스
+			blocks = append(blocks, renderCodeBlock(strings.Join(code, "\n"), lang))
+
+		case reListItem.MatchString(line):
+			ordered := reOrderedItem.MatchString(reListItem.FindStringSubmatch(line)[1])
+			var items []string
+			for ; i < len(lines) && reListItem.MatchString(lines[i]); i++ {
+				items = append(items, "<li>"+renderInline(reListItem.FindStringSubmatch(lines[i])[2])+"</li>")
+			}
+			tag := "ul"
+			if ordered {
+				tag = "ol"
+			}
+			blocks = append(blocks, fmt.Sprintf("<%s>%s</%s>", tag, strings.Join(items, ""), tag))
+
+		default:
+			var para []string
+			for ; i < len(lines); i++ {
+				l := lines[i]
+				if strings.TrimSpace(l) == "" || reFence.MatchString(l) || reListItem.MatchString(l) {
+					break
+				}
+				para = append(para, strings.TrimSpace(l))
+			}
+			paragraphs++
+			blocks = append(blocks, "<p>"+renderInline(strings.Join(para, " "))+"</p>")
+		}
+	}
+
+	if len(blocks) == 1 && paragraphs == 1 {
+		return strings.TrimSuffix(strings.TrimPrefix(blocks[0], "<p>"), "</p>")
+	}
+	return strings.Join(blocks, "")
+}
+
+// renderCodeBlock은 펜스 코드 블록을 <pre>로 만듭니다. 줄바꿈은 &#10;로 바꿔 한 줄로 유지합니다.
+func renderCodeBlock(code, lang string) string {
+	class := ""
+	if lang != "" {
+		class = fmt.Sprintf(` class="language-%s"`, lang)
+	}
+	escaped := strings.ReplaceAll(html.EscapeString(code), "\n", "&#10;")
+	return fmt.Sprintf(`<pre><code%s>%s</code></pre>`, class, escaped)
+}
+
+// renderInline은 코드 스팬, 강조, 링크를 HTML로 바꿉니다. 코드 스팬 안의 내용은 해석하지 않습니다.
+func renderInline(text string) string {
+	var b strings.Builder
+	parts := strings.Split(text, "`")
+	for i, part := range parts {
+		// 홀수 번째 조각은 코드 스팬입니다. 닫히지 않은 마지막 백틱은 글자 그대로 둡니다.
+		if i%2 == 1 && i < len(parts)-1 {
+			b.WriteString("<code>" + html.EscapeString(part) + "</code>")
+			continue
+		}
+		if i%2 == 1 {
+			b.WriteString("`")
+		}
+		s := html.EscapeString(part)
+		s = reInlineLink.ReplaceAllString(s, `<a href="$2">$1</a>`)
+		s = reBold.ReplaceAllString(s, "<strong>$1</strong>")
+		s = reItalic.ReplaceAllString(s, "<em>$1</em>")
+		b.WriteString(s)
+	}
+	return b.String()
+}
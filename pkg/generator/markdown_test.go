@@ -0,0 +1,45 @@
+package generator
+
+import "testing"
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "Single line stays inline",
+			input: "Sends a <message> to the server",
+			want:  "Sends a &lt;message&gt; to the server",
+		},
+		{
+			name:  "Code span and emphasis",
+			input: "Returns `nil` when **not** found, see [docs](http://x.y/z)",
+			want:  `Returns <code>nil</code> when <strong>not</strong> found, see <a href="http://x.y/z">docs</a>`,
+		},
+		{
+			name:  "Paragraphs and list",
+			input: "First line\ncontinues here.\n\n- one\n- `two`",
+			want:  "<p>First line continues here.</p><ul><li>one</li><li><code>two</code></li></ul>",
+		},
+		{
+			name:  "Ordered list",
+			input: "1. first\n2. second",
+			want:  "<ol><li>first</li><li>second</li></ol>",
+		},
+		{
+			name:  "Fenced code keeps a single line",
+			input: "Usage:\n```lua\nlocal a = 1\n\nif a < 2 then end\n```",
+			want:  `<p>Usage:</p><pre><code class="language-lua">local a = 1&#10;&#10;if a &lt; 2 then end</code></pre>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderMarkdown(tt.input); got != tt.want {
+				t.Errorf("renderMarkdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"path"
+	"sort"
+)
+
+// Project는 프로젝트 안의 모든 문서를 스크립트 이름으로 찾을 수 있게 모아 둡니다.
+type Project struct {
+	scripts map[string]*ProjectScript
+}
+
+// ProjectScript는 프로젝트에 등록된 문서 하나와 그 문서 페이지의 경로입니다.
+type ProjectScript struct {
+	Name string
+	Doc  *document.Documentation
+	Path string // 출력 루트 기준 Markdown 경로 (슬래시 구분). 페이지를 만들지 않는 문서는 비어 있습니다.
+}
+
+// HasPage는 이 문서의 페이지가 만들어지는지 확인합니다. 페이지가 없는 문서도 이름으로 찾을 수는 있지만 링크하지 않습니다.
+func (s *ProjectScript) HasPage() bool {
+	return s.Path != ""
+}
+
+func NewProject() *Project {
+	return &Project{scripts: make(map[string]*ProjectScript)}
+}
+
+// Add는 문서를 스크립트 이름으로 등록합니다. 같은 이름이 있으면 덮어씁니다.
+// pagePath가 비어 있으면 상속, 타입, ---@see 대상으로만 쓰이고 페이지는 없는 문서로 등록됩니다.
+func (p *Project) Add(name string, doc *document.Documentation, pagePath string) {
+	p.scripts[name] = &ProjectScript{Name: name, Doc: doc, Path: pagePath}
+}
+
+// Lookup은 스크립트 이름으로 문서를 찾습니다.
+func (p *Project) Lookup(name string) (*ProjectScript, bool) {
+	if p == nil {
+		return nil, false
+	}
+	s, ok := p.scripts[name]
+	return s, ok
+}
+
+// Scripts는 등록된 문서를 스크립트 이름 순으로 반환합니다.
+func (p *Project) Scripts() []*ProjectScript {
+	scripts := make([]*ProjectScript, 0, len(p.scripts))
+	for _, s := range p.scripts {
+		scripts = append(scripts, s)
+	}
+	sort.Slice(scripts, func(i, j int) bool { return scripts[i].Name < scripts[j].Name })
+	return scripts
+}
+
+// Pages는 페이지가 만들어지는 문서만 스크립트 이름 순으로 반환합니다.
+func (p *Project) Pages() []*ProjectScript {
+	var pages []*ProjectScript
+	for _, s := range p.Scripts() {
+		if s.HasPage() {
+			pages = append(pages, s)
+		}
+	}
+	return pages
+}
+
+// linkableTypes는 다른 문서의 시그니처에서 타입으로 참조될 수 있는 스크립트 종류입니다.
+var linkableTypes = map[string]bool{
+	"Logic":     true,
+	"Component": true,
+	"BTNode":    true,
+	"Item":      true,
+	"State":     true,
+	"Event":     true,
+	"Struct":    true,
+	"Enum":      true,
+}
+
+// TypeLinks는 fromPage 페이지에서 쓸 타입 링크 표를 만듭니다. 링크는 fromPage 기준의 상대 경로입니다.
+// 종류 어트리뷰트가 있는 스크립트와 상수 테이블이 모두 포함됩니다.
+func (p *Project) TypeLinks(fromPage string) TypeLinkInfo {
+	links := make(TypeLinkInfo)
+	if p == nil {
+		return links
+	}
+	for name, s := range p.scripts {
+		if s.HasPage() && (linkableTypes[s.Doc.DocType] || s.Doc.Constants) {
+			links[name] = relativeLink(fromPage, s.Path)
+		}
+	}
+	return links
+}
+
+// Ancestors는 doc의 부모부터 차례로, 프로젝트 안에서 찾을 수 있는 조상 문서를 반환합니다.
+// Logic, Component처럼 엔진이 제공하는 타입에 닿거나 순환 참조가 생기면 멈춥니다.
+func (p *Project) Ancestors(doc *document.Documentation) []*ProjectScript {
+	var ancestors []*ProjectScript
+	visited := map[string]bool{doc.Name: true}
+	for name := doc.Extends; name != "" && !visited[name]; {
+		parent, ok := p.Lookup(name)
+		if !ok {
+			break
+		}
+		visited[name] = true
+		ancestors = append(ancestors, parent)
+		name = parent.Doc.Extends
+	}
+	return ancestors
+}
+
+// relativeLink는 from 페이지에서 to 페이지로 가는 상대 링크를 만듭니다. 두 경로 모두 출력 루트 기준입니다.
+func relativeLink(from, to string) string {
+	fromParts := splitPath(path.Dir(from))
+	toParts := splitPath(path.Dir(to))
+
+	common := 0
+	for common < len(fromParts) && common < len(toParts) && fromParts[common] == toParts[common] {
+		common++
+	}
+
+	var parts []string
+	for range fromParts[common:] {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, toParts[common:]...)
+	parts = append(parts, path.Base(to))
+	return path.Join(parts...)
+}
+
+func splitPath(dir string) []string {
+	var parts []string
+	for dir != "." && dir != "/" && dir != "" {
+		parts = append([]string{path.Base(dir)}, parts...)
+		dir = path.Dir(dir)
+	}
+	return parts
+}
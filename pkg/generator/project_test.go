@@ -0,0 +1,113 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+	"testing"
+)
+
+func TestProjectAncestorsStopsOnCycle(t *testing.T) {
+	a := &document.Documentation{Name: "A", Extends: "B"}
+	b := &document.Documentation{Name: "B", Extends: "A"}
+
+	project := NewProject()
+	project.Add("A", a, "a.md")
+	project.Add("B", b, "b.md")
+
+	ancestors := project.Ancestors(a)
+	if len(ancestors) != 1 || ancestors[0].Name != "B" {
+		t.Errorf("Ancestors = %v, want only B", ancestors)
+	}
+}
+
+func TestRelativeLink(t *testing.T) {
+	tests := []struct {
+		from, to, want string
+	}{
+		{"logic/A.md", "logic/B.md", "B.md"},
+		{"logic/A.md", "struct/B.md", "../struct/B.md"},
+		{"index.md", "event/E.md", "event/E.md"},
+		{"event/E.md", "index.md", "../index.md"},
+	}
+
+	for _, tt := range tests {
+		if got := relativeLink(tt.from, tt.to); got != tt.want {
+			t.Errorf("relativeLink(%q, %q) = %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestProjectTypeLinks(t *testing.T) {
+	project := NewProject()
+	project.Add("GameLogic", &document.Documentation{DocType: "Logic", Name: "GameLogic"}, "logic/GameLogic.md")
+	project.Add("Mover", &document.Documentation{DocType: "Component", Name: "Mover"}, "component/Mover.md")
+	project.Add("Hit", &document.Documentation{DocType: "Struct", Name: "Hit"}, "struct/Hit.md")
+	project.Add("Limits", &document.Documentation{Name: "Limits", Constants: true}, "etc/Limits.md")
+	project.Add("Helper", &document.Documentation{Name: "Helper"}, "etc/Helper.md")
+
+	links := project.TypeLinks("component/Mover.md")
+	want := TypeLinkInfo{
+		"GameLogic": "../logic/GameLogic.md",
+		"Mover":     "Mover.md",
+		"Hit":       "../struct/Hit.md",
+		"Limits":    "../etc/Limits.md",
+	}
+	if len(links) != len(want) {
+		t.Errorf("TypeLinks = %v, want %v", links, want)
+	}
+	for name, link := range want {
+		if links[name] != link {
+			t.Errorf("TypeLinks[%q] = %q, want %q", name, links[name], link)
+		}
+	}
+}
+
+func TestProjectScriptsWithoutPages(t *testing.T) {
+	base := &document.Documentation{
+		DocType: "Component",
+		Name:    "BaseMover",
+		Methods: []document.MethodDoc{{Name: "Move", ReturnType: "void"}},
+	}
+	hit := &document.Documentation{DocType: "Struct", Name: "HitInfo"}
+	child := &document.Documentation{
+		DocType: "Component",
+		Name:    "Child",
+		Extends: "BaseMover",
+		See:     []document.SeeRef{{Target: "BaseMover.Move"}},
+		Methods: []document.MethodDoc{{Name: "Hit", ReturnType: "void", Params: []document.ParamInfo{{Name: "info", Type: "HitInfo"}}}},
+	}
+	project := NewProject()
+	project.Add("BaseMover", base, "")
+	project.Add("HitInfo", hit, "")
+	project.Add("Child", child, "component/Child.md")
+
+	if links := project.TypeLinks("component/Child.md"); len(links) != 1 || links["Child"] != "Child.md" {
+		t.Errorf("TypeLinks = %v, want only Child", links)
+	}
+	if pages := project.ScriptsIn("."); len(pages) != 1 || pages[0].Name != "Child" {
+		t.Errorf("ScriptsIn(.) = %v, want only Child", pages)
+	}
+	if folders := project.Folders(); len(folders) != 1 || folders[0] != "component" {
+		t.Errorf("Folders() = %v, want [component]", folders)
+	}
+	if diags := project.CheckSeeRefs(child); len(diags) != 0 {
+		t.Errorf("CheckSeeRefs = %v, want none for a script without a page", diags)
+	}
+
+	md, err := Generate(child, Page{Path: "component/Child.md", TypeLinks: project.TypeLinks("component/Child.md"), Project: project})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	for _, want := range []string{"## Inherited from BaseMover\n", "- **Methods**: Move\n", "**See also**: `BaseMover.Move`"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Expected %q not found in output:\n%s", want, md)
+		}
+	}
+	if strings.Contains(md, "BaseMover.md") || strings.Contains(md, "HitInfo.md") {
+		t.Errorf("Scripts without a page should not be linked:\n%s", md)
+	}
+
+	if index := GenerateIndex(project, "."); strings.Contains(index, "[BaseMover]") || strings.Contains(index, "class BaseMover") {
+		t.Errorf("Index should only list scripts with pages:\n%s", index)
+	}
+}
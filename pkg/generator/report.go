@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenerateDeprecatedReport는 프로젝트 전체에서 사용 중단된 스크립트와 멤버를 한 페이지로 모읍니다.
+// reportPath는 출력 루트 기준 보고서 페이지의 경로이며, 각 스크립트 문서로 가는 상대 링크를 만드는 데 쓰입니다.
+func GenerateDeprecatedReport(project *Project, reportPath string) string {
+	var b strings.Builder
+	b.WriteString("# Deprecated APIs\n\n")
+
+	var rows []string
+	for _, s := range project.Pages() {
+		link := fmt.Sprintf("[%s](%s)", s.Name, relativeLink(reportPath, s.Path))
+		doc := s.Doc
+		if doc.Deprecated {
+			rows = append(rows, reportRow(link, "", "script", doc.DeprecatedMessage))
+		}
+		for _, p := range doc.Properties {
+			if p.Deprecated {
+				rows = append(rows, reportRow(link, p.Name, "property", p.DeprecatedMessage))
+			}
+		}
+		for _, m := range doc.Methods {
+			if m.Deprecated {
+				rows = append(rows, reportRow(link, m.Name, "method", m.DeprecatedMessage))
+			}
+		}
+		for _, h := range doc.Handlers {
+			if h.Deprecated {
+				rows = append(rows, reportRow(link, h.Name, "handler", h.DeprecatedMessage))
+			}
+		}
+	}
+
+	if len(rows) == 0 {
+		b.WriteString("사용 중단된 API가 없습니다.\n")
+		return b.String()
+	}
+
+	b.WriteString("| Script | Member | Kind | Message |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+	for _, row := range rows {
+		b.WriteString(row)
+	}
+	return b.String()
+}
+
+func reportRow(scriptLink, member, kind, message string) string {
+	if member != "" {
+		member = "`" + member + "`"
+	}
+	return fmt.Sprintf("| %s | %s | %s | %s |\n", scriptLink, member, kind, tableCell(message))
+}
+
+// tableCell은 Markdown 테이블 셀을 깨뜨리는 `|`와 줄바꿈을 바꿉니다.
+func tableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,41 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+	"testing"
+)
+
+func TestGenerateDeprecatedReport(t *testing.T) {
+	project := NewProject()
+	project.Add("OldLogic", &document.Documentation{
+		Name:              "OldLogic",
+		Deprecated:        true,
+		DeprecatedMessage: "Use NewLogic | NewerLogic",
+		Methods: []document.MethodDoc{
+			{Name: "SendOld", Deprecated: true, DeprecatedMessage: "Use Send\ninstead"},
+			{Name: "Send"},
+		},
+	}, "logic/OldLogic.md")
+	project.Add("Clean", &document.Documentation{Name: "Clean"}, "logic/Clean.md")
+
+	report := GenerateDeprecatedReport(project, "deprecated.md")
+
+	expected := []string{
+		"| [OldLogic](logic/OldLogic.md) |  | script | Use NewLogic \\| NewerLogic |",
+		"| [OldLogic](logic/OldLogic.md) | `SendOld` | method | Use Send instead |",
+	}
+	for _, e := range expected {
+		if !strings.Contains(report, e) {
+			t.Errorf("Expected row %q not found in report:\n%s", e, report)
+		}
+	}
+	if strings.Contains(report, "Clean") || strings.Contains(report, "`Send`") {
+		t.Errorf("Report should only list deprecated APIs:\n%s", report)
+	}
+
+	empty := GenerateDeprecatedReport(NewProject(), "deprecated.md")
+	if !strings.Contains(empty, "사용 중단된 API가 없습니다.") {
+		t.Errorf("Expected empty report message, got:\n%s", empty)
+	}
+}
@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"fmt"
+	"generate_api_docs_mLua/pkg/document"
+	"html"
+	"strings"
+)
+
+// seeLink는 해석한 ---@see 참조 하나입니다. Href가 비어 있으면 대상을 찾지 못한 것입니다.
+type seeLink struct {
+	Text, Href string
+}
+
+// findMember는 doc에서 이름이 name인 프로퍼티, 메서드, 핸들러를 찾아 문서 페이지 안의 앵커를 반환합니다.
+func findMember(doc *document.Documentation, name string) (anchor string, ok bool) {
+	for _, p := range doc.Properties {
+		if p.Name == name {
+			return memberAnchor("property", name), true
+		}
+	}
+	for _, m := range doc.Methods {
+		if m.Name == name {
+			return memberAnchor("method", name), true
+		}
+	}
+	for _, h := range doc.Handlers {
+		if h.Name == name {
+			return memberAnchor("handler", name), true
+		}
+	}
+	return "", false
+}
+
+// ResolveSee는 doc에 적힌 ---@see 대상을 프로젝트에서 찾습니다.
+// `#Member`는 doc 자신의 멤버, `Script.Member`는 다른 스크립트(또는 그 조상)의 멤버, `Script`는 스크립트 자체입니다.
+// 찾은 대상이 doc 자신의 멤버이면 script는 nil입니다.
+func (p *Project) ResolveSee(doc *document.Documentation, target string) (script *ProjectScript, member string, ok bool) {
+	if name, local := strings.CutPrefix(target, "#"); local {
+		_, ok := findMember(doc, name)
+		return nil, name, ok
+	}
+
+	name, member, _ := strings.Cut(target, ".")
+	script, ok = p.Lookup(name)
+	if !ok || member == "" {
+		return script, "", ok
+	}
+	for _, s := range append([]*ProjectScript{script}, p.Ancestors(script.Doc)...) {
+		if _, found := findMember(s.Doc, member); found {
+			return s, member, true
+		}
+	}
+	return nil, "", false
+}
+
+// CheckSeeRefs는 doc과 그 멤버의 ---@see 중 프로젝트에서 찾을 수 없는 대상을 경고로 반환합니다.
+func (p *Project) CheckSeeRefs(doc *document.Documentation) document.Diagnostics {
+	var diags document.Diagnostics
+	for _, ref := range allSeeRefs(doc) {
+		if _, _, ok := p.ResolveSee(doc, ref.Target); !ok {
+			diags = append(diags, document.Diagnostic{
+				Severity: document.SeverityWarning,
+				Code:     document.CodeUnresolvedSee,
+				Message:  fmt.Sprintf("@see 대상 %s를 찾을 수 없습니다", ref.Target),
+				Pos:      ref.Pos,
+			})
+		}
+	}
+	return diags
+}
+
+// allSeeRefs는 스크립트와 모든 멤버의 ---@see를 선언 순서대로 모읍니다.
+func allSeeRefs(doc *document.Documentation) []document.SeeRef {
+	refs := append([]document.SeeRef(nil), doc.See...)
+	for _, p := range doc.Properties {
+		refs = append(refs, p.See...)
+	}
+	for _, m := range doc.Methods {
+		refs = append(refs, m.See...)
+	}
+	for _, h := range doc.Handlers {
+		refs = append(refs, h.See...)
+	}
+	return refs
+}
+
+// seeLinks는 ---@see 대상을 page에서 쓸 링크로 바꿉니다. 스크립트는 그 문서 페이지로,
+// 멤버는 그 페이지 안의 멤버 앵커로 연결합니다.
+func seeLinks(refs []document.SeeRef, doc *document.Documentation, page Page) []seeLink {
+	var links []seeLink
+	for _, ref := range refs {
+		link := seeLink{Text: strings.TrimPrefix(ref.Target, "#")}
+		script, member, ok := page.Project.ResolveSee(doc, ref.Target)
+		switch {
+		case !ok:
+		case script == nil:
+			anchor, _ := findMember(doc, member)
+			link.Href = "#" + anchor
+		case !script.HasPage():
+			// 페이지가 없는 문서는 찾을 수는 있지만 링크하지 않습니다.
+		case member != "":
+			anchor, _ := findMember(script.Doc, member)
+			link.Href = relativeLink(page.Path, script.Path) + "#" + anchor
+		default:
+			link.Href = relativeLink(page.Path, script.Path)
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+// renderSeeAlso는 테이블 아래에 둘 `**See also**` 줄을 Markdown으로 만듭니다.
+func renderSeeAlso(links []seeLink) string {
+	if len(links) == 0 {
+		return ""
+	}
+	items := make([]string, len(links))
+	for i, l := range links {
+		if l.Href == "" {
+			items[i] = "`" + l.Text + "`"
+			continue
+		}
+		items[i] = fmt.Sprintf("[%s](%s)", l.Text, l.Href)
+	}
+	return fmt.Sprintf("\n\n**See also**: %s\n\n", strings.Join(items, ", "))
+}
+
+// seeAlsoHTML은 테이블 셀 안에 들어갈 See also 목록을 HTML로 만듭니다.
+func seeAlsoHTML(links []seeLink) string {
+	if len(links) == 0 {
+		return ""
+	}
+	items := make([]string, len(links))
+	for i, l := range links {
+		if l.Href == "" {
+			items[i] = "<code>" + html.EscapeString(l.Text) + "</code>"
+			continue
+		}
+		items[i] = fmt.Sprintf(`<a href="%s">%s</a>`, l.Href, html.EscapeString(l.Text))
+	}
+	return "<strong>See also</strong>: " + strings.Join(items, ", ")
+}
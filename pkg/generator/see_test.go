@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"generate_api_docs_mLua/pkg/document"
+	"strings"
+	"testing"
+)
+
+func TestProjectResolveSee(t *testing.T) {
+	base := &document.Documentation{Name: "Unit", Methods: []document.MethodDoc{{Name: "Die"}}}
+	event := &document.Documentation{Name: "DamageEvent", Extends: "Unit", Properties: []document.PropertyDoc{{Name: "amount"}}}
+	combat := &document.Documentation{Name: "Combat", Methods: []document.MethodDoc{{Name: "Heal"}}}
+
+	project := NewProject()
+	project.Add("Unit", base, "logic/Unit.md")
+	project.Add("DamageEvent", event, "event/DamageEvent.md")
+	project.Add("Combat", combat, "logic/Combat.md")
+
+	tests := []struct {
+		target     string
+		wantScript string
+		wantMember string
+		wantOK     bool
+	}{
+		{"DamageEvent", "DamageEvent", "", true},
+		{"DamageEvent.amount", "DamageEvent", "amount", true},
+		{"DamageEvent.Die", "Unit", "Die", true},
+		{"#Heal", "", "Heal", true},
+		{"#Missing", "", "", false},
+		{"DamageEvent.missing", "", "", false},
+		{"Missing", "", "", false},
+	}
+
+	for _, tt := range tests {
+		script, member, ok := project.ResolveSee(combat, tt.target)
+		name := ""
+		if script != nil {
+			name = script.Name
+		}
+		if ok != tt.wantOK || (ok && (name != tt.wantScript || member != tt.wantMember)) {
+			t.Errorf("ResolveSee(%q) = (%q, %q, %v), want (%q, %q, %v)", tt.target, name, member, ok, tt.wantScript, tt.wantMember, tt.wantOK)
+		}
+	}
+}
+
+func TestProjectCheckSeeRefs(t *testing.T) {
+	doc := &document.Documentation{
+		Name: "Combat",
+		See:  []document.SeeRef{{Target: "DamageEvent", Pos: document.Pos{Line: 1, Column: 1}}},
+		Methods: []document.MethodDoc{
+			{Name: "Attack", See: []document.SeeRef{{Target: "Missing.Member", Pos: document.Pos{Line: 4, Column: 2}}}},
+		},
+	}
+	project := NewProject()
+	project.Add("Combat", doc, "logic/Combat.md")
+	project.Add("DamageEvent", &document.Documentation{Name: "DamageEvent"}, "event/DamageEvent.md")
+
+	diags := project.CheckSeeRefs(doc)
+	if len(diags) != 1 || diags[0].Code != document.CodeUnresolvedSee || diags[0].Pos.Line != 4 {
+		t.Errorf("CheckSeeRefs = %v, want a single unresolved-see diagnostic at line 4", diags)
+	}
+}
+
+func TestGenerateSeeAlso(t *testing.T) {
+	event := &document.Documentation{Name: "DamageEvent", DocType: "Event"}
+	doc := &document.Documentation{
+		Name: "Combat",
+		See:  []document.SeeRef{{Target: "DamageEvent"}},
+		Properties: []document.PropertyDoc{
+			{Name: "hp", Type: "number", See: []document.SeeRef{{Target: "#Heal"}}},
+		},
+		Methods: []document.MethodDoc{
+			{Name: "Heal", ReturnType: "void", Span: document.Span{Start: document.Pos{Line: 7}}, See: []document.SeeRef{{Target: "Unknown"}}},
+		},
+	}
+	project := NewProject()
+	project.Add("Combat", doc, "logic/Combat.md")
+	project.Add("DamageEvent", event, "event/DamageEvent.md")
+
+	md, err := Generate(doc, Page{SourceLink: "../../Combat.mlua", Path: "logic/Combat.md", Project: project})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	expected := []string{
+		"**See also**: [DamageEvent](../event/DamageEvent.md)",
+		`<br><strong>See also</strong>: <a href="#method-Heal">Heal</a>`,
+		"**See also**: `Unknown`",
+	}
+	for _, e := range expected {
+		if !strings.Contains(md, e) {
+			t.Errorf("Expected %q not found in output:\n%s", e, md)
+		}
+	}
+}
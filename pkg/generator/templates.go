@@ -13,31 +13,59 @@ var DocumentTemplateInline = `<table style="width: 100%; border-collapse: collap
     <thead>
         <tr>
             <th style="background-color: #f0f0f0; padding: 10px 5px; text-align: left; vertical-align: top;">
-                <span style="color: #3167ad;">{{.ReturnType}}</span> <span style="font-weight: bold;">{{.FunctionName}}</span>({{.FunctionParamsStr}}){{.BadgeHTML}}
+                {{if .Anchor}}<a id="{{.Anchor}}"></a>{{end}}<span style="color: #3167ad;">{{.ReturnTypeSignatureHTML}}</span> <span style="font-weight: bold;">{{.FunctionNameHTML}}</span>({{.FunctionParamsStr}}){{.BadgeHTML}}
             </th>
         </tr>
     </thead>
-    <tbody>{{- if .Description}}
+    <tbody>{{- if .Deprecated}}
+        <tr>
+            <td style="background-color: #fff8c5; padding: 10px 5px; text-align: left; vertical-align: top;">
+                <strong>Deprecated</strong>{{if .DeprecatedMessage}} &nbsp;|&nbsp; {{markdown .DeprecatedMessage}}{{end}}
+            </td>
+        </tr>{{- end}}{{- if .Description}}
         <tr>
             <td style="background-color: #fff; padding: 10px 5px; text-align: left; vertical-align: top;">
-                {{.Description}}
+                {{markdown .Description}}
             </td>
         </tr>{{- end}}{{- range .Params}}{{- if .Description}}
         <tr>
             <td style="background-color: #fafafa; border-top: 1px solid #eee; padding: 10px 5px 10px 15px; text-align: left; vertical-align: top;">
                 <code style="background-color: #e1e4e8; padding: 2px 5px; border-radius: 4px; font-family: monospace;">{{.Name}}</code>
-                <span style="color: #57606a;"> &nbsp;|&nbsp; {{.Description}}</span>
+                <span style="color: #57606a;"> &nbsp;|&nbsp; {{markdown .Description}}{{if .Default}} (기본값: <code>{{.Default}}</code>){{end}}</span>
+            </td>
+        </tr>{{- end}}{{- end}}{{- if .ReturnDescription}}
+        <tr>
+            <td style="background-color: #fafafa; border-top: 1px solid #eee; padding: 10px 5px 10px 15px; text-align: left; vertical-align: top;">
+                <strong>Returns</strong> {{.ReturnTypeHTML}}
+                <span style="color: #57606a;"> &nbsp;|&nbsp; {{markdown .ReturnDescription}}</span>
+            </td>
+        </tr>{{- end}}{{- range $name, $value := .Metadata}}
+        <tr>
+            <td style="background-color: #fafafa; border-top: 1px solid #eee; padding: 10px 5px 10px 15px; text-align: left; vertical-align: top;">
+                <strong>{{$name}}</strong>{{if $value}}<span style="color: #57606a;"> &nbsp;|&nbsp; {{markdown $value}}</span>{{end}}
             </td>
-        </tr>{{- end}}{{- end}}
+        </tr>{{- end}}
     </tbody>
 </table>
 `
 
+// Badges는 어트리뷰트를 나타내는 뱃지입니다. 키는 `이름:첫 번째 인자`(예: ExecSpace:ServerOnly)이고,
+// 인자와 상관없는 어트리뷰트는 `이름`(예: Sync)만 씁니다. 새 어트리뷰트는 여기에 항목을 추가하면 바로 표시됩니다.
 var Badges = map[string]string{
-	"ServerOnly": ` <img src="https://img.shields.io/badge/ServerOnly-da70d6" alt="ServerOnly" style="vertical-align: middle; margin-left: 8px;">`,
-	"ClientOnly": ` <img src="https://img.shields.io/badge/ClientOnly-87ceeb" alt="ClientOnly" style="vertical-align: middle; margin-left: 8px;">`,
-	"Server":     ` <img src="https://img.shields.io/badge/Server-ffa500" alt="Server" style="vertical-align: middle; margin-left: 8px;">`,
-	"Client":     ` <img src="https://img.shields.io/badge/Client-90ee90" alt="Client" style="vertical-align: middle; margin-left: 8px;">`,
-	"Logic":      ` <img src="https://img.shields.io/badge/Logic-95e1d3" alt="Logic" style="vertical-align: middle; margin-left: 8px;">`,
-	"Service":    ` <img src="https://img.shields.io/badge/Service-f38181" alt="Service" style="vertical-align: middle; margin-left: 8px;">`,
+	"ExecSpace:ServerOnly":    ` <img src="https://img.shields.io/badge/ServerOnly-da70d6" alt="ServerOnly" style="vertical-align: middle; margin-left: 8px;">`,
+	"ExecSpace:ClientOnly":    ` <img src="https://img.shields.io/badge/ClientOnly-87ceeb" alt="ClientOnly" style="vertical-align: middle; margin-left: 8px;">`,
+	"ExecSpace:Server":        ` <img src="https://img.shields.io/badge/Server-ffa500" alt="Server" style="vertical-align: middle; margin-left: 8px;">`,
+	"ExecSpace:Client":        ` <img src="https://img.shields.io/badge/Client-90ee90" alt="Client" style="vertical-align: middle; margin-left: 8px;">`,
+	"EventSender:Logic":       ` <img src="https://img.shields.io/badge/Logic-95e1d3" alt="Logic" style="vertical-align: middle; margin-left: 8px;">`,
+	"EventSender:Service":     ` <img src="https://img.shields.io/badge/Service-f38181" alt="Service" style="vertical-align: middle; margin-left: 8px;">`,
+	"EventSender:Entity":      ` <img src="https://img.shields.io/badge/Entity-fce38a" alt="Entity" style="vertical-align: middle; margin-left: 8px;">`,
+	"EventSender:Model":       ` <img src="https://img.shields.io/badge/Model-eaffd0" alt="Model" style="vertical-align: middle; margin-left: 8px;">`,
+	"EventSender:LocalPlayer": ` <img src="https://img.shields.io/badge/LocalPlayer-a8d8ea" alt="LocalPlayer" style="vertical-align: middle; margin-left: 8px;">`,
+	"EventSender:Self":        ` <img src="https://img.shields.io/badge/Self-c9c9c9" alt="Self" style="vertical-align: middle; margin-left: 8px;">`,
+	"Deprecated":              ` <img src="https://img.shields.io/badge/Deprecated-d73a49" alt="Deprecated" style="vertical-align: middle; margin-left: 8px;">`,
+	"Local":                   ` <img src="https://img.shields.io/badge/Local-6e7781" alt="Local" style="vertical-align: middle; margin-left: 8px;">`,
+	// 프로퍼티 동기화/인스펙터 어트리뷰트
+	"Sync":              ` <img src="https://img.shields.io/badge/Sync-6f42c1" alt="Sync" style="vertical-align: middle; margin-left: 8px;">`,
+	"TargetUserSync":    ` <img src="https://img.shields.io/badge/TargetUserSync-a371f7" alt="TargetUserSync" style="vertical-align: middle; margin-left: 8px;">`,
+	"HideFromInspector": ` <img src="https://img.shields.io/badge/HideFromInspector-8b949e" alt="HideFromInspector" style="vertical-align: middle; margin-left: 8px;">`,
 }